@@ -0,0 +1,364 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// defaultStdPath is the import path of std used when the contract file does not import it.
+const defaultStdPath = "github.com/CosmWasm/cosmwasm-go/std"
+
+// returnsPattern matches the "returns XResponse" documentation of the QueryMsg variants.
+// Only exported type names match, so that "returns the balance" is left alone.
+var returnsPattern = regexp.MustCompile(`\b[Rr]eturns\s+(?:an?\s+)?\x60?([A-Z]\w*|[a-z]\w*\.[A-Z]\w*)\b`)
+
+// Config selects the messages read by the generator and the name of the client.
+type Config struct {
+	ExecuteMsg string
+	QueryMsg   string
+	Client     string
+}
+
+// variant is a field of the ExecuteMsg or QueryMsg enum structs.
+type variant struct {
+	Name string
+	// Type is the type of the variant message, empty for *struct{} variants.
+	Type string
+	// Response is the type returned by a query variant.
+	Response string
+	Doc      []string
+}
+
+type client struct {
+	Package    string
+	Imports    map[string]string
+	Name       string
+	ExecuteMsg string
+	QueryMsg   string
+	Executes   []variant
+	Queries    []variant
+}
+
+// OutputName returns the name of the file generated from the file name.
+func OutputName(name string) string {
+	return strings.TrimSuffix(name, ".go") + "_client.go"
+}
+
+// Generate returns the client of the messages declared in the file name.
+// The other files of the package are read to find the response types
+// which are not documented.
+func Generate(name string, cfg Config) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	declared, err := packageTypes(fset, filepath.Dir(name), file.Name.Name)
+	if err != nil {
+		return nil, err
+	}
+	fileImports := importNames(file)
+
+	c := client{
+		Package:    file.Name.Name,
+		Imports:    make(map[string]string),
+		Name:       cfg.Client,
+		ExecuteMsg: cfg.ExecuteMsg,
+		QueryMsg:   cfg.QueryMsg,
+	}
+	stdPath := stdImportPath(file)
+	c.Imports["std"] = stdPath
+	c.Imports["types"] = stdPath + "/types"
+
+	execute := findStruct(file, cfg.ExecuteMsg)
+	query := findStruct(file, cfg.QueryMsg)
+	if execute == nil && query == nil {
+		return nil, fmt.Errorf("%s: neither %s nor %s is declared", name, cfg.ExecuteMsg, cfg.QueryMsg)
+	}
+	if execute != nil {
+		c.Executes, err = variants(cfg.ExecuteMsg, execute)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if query != nil {
+		c.Queries, err = variants(cfg.QueryMsg, query)
+		if err != nil {
+			return nil, err
+		}
+		for i := range c.Queries {
+			q := &c.Queries[i]
+			q.Response, err = responseType(cfg.QueryMsg, *q, declared)
+			if err != nil {
+				return nil, err
+			}
+			if dot := strings.IndexByte(q.Response, '.'); dot >= 0 {
+				alias := q.Response[:dot]
+				path, ok := fileImports[alias]
+				if !ok {
+					return nil, fmt.Errorf("%s.%s: package %s of %s is not imported", cfg.QueryMsg, q.Name, alias, q.Response)
+				}
+				q.Response, err = c.qualify(alias, path, q.Response[dot+1:])
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", cfg.QueryMsg, q.Name, err)
+				}
+			}
+		}
+	}
+
+	buf := bytes.Buffer{}
+	if err := clientTemplate.Execute(&buf, c); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// qualify imports the package path as alias in the client file, unless it is already
+// imported, and returns the qualified name of the type name of the package.
+func (c client) qualify(alias, path, name string) (string, error) {
+	for imported, importedPath := range c.Imports {
+		if importedPath == path {
+			return imported + "." + name, nil
+		}
+	}
+	if existing, ok := c.Imports[alias]; ok {
+		return "", fmt.Errorf("package %s conflicts with %s", path, existing)
+	}
+	c.Imports[alias] = path
+	return alias + "." + name, nil
+}
+
+// packageTypes returns the names of the types declared by the package pkg in dir.
+func packageTypes(fset *token.FileSet, dir, pkg string) (map[string]bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	declared := make(map[string]bool)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if file.Name.Name != pkg {
+			continue
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				declared[spec.(*ast.TypeSpec).Name.Name] = true
+			}
+		}
+	}
+	return declared, nil
+}
+
+// importNames maps the names of the packages imported by file to their path.
+func importNames(file *ast.File) map[string]string {
+	names := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndexByte(path, '/')+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		names[name] = path
+	}
+	return names
+}
+
+// stdImportPath returns the import path of std used by file, so that the client
+// builds with forks of cosmwasm-go.
+func stdImportPath(file *ast.File) string {
+	for _, path := range importNames(file) {
+		if strings.HasSuffix(path, "/std") {
+			return path
+		}
+		if strings.HasSuffix(path, "/std/types") {
+			return strings.TrimSuffix(path, "/types")
+		}
+	}
+	return defaultStdPath
+}
+
+func findStruct(file *ast.File, name string) *ast.StructType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if ts.Name.Name != name {
+				continue
+			}
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				return st
+			}
+		}
+	}
+	return nil
+}
+
+// variants returns the fields of the enum struct msg, which must all be pointers
+// to a named type or to struct{}.
+func variants(msg string, st *ast.StructType) ([]variant, error) {
+	var result []variant
+	for _, field := range st.Fields.List {
+		if field.Tag != nil {
+			tag, _ := strconv.Unquote(field.Tag.Value)
+			if strings.HasPrefix(reflectTag(tag, "json"), "-") {
+				continue
+			}
+		}
+		if len(field.Names) != 1 {
+			return nil, fmt.Errorf("%s: embedded and grouped fields are not supported", msg)
+		}
+		v := variant{Name: field.Names[0].Name}
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: variants must be pointers", msg, v.Name)
+		}
+		switch t := star.X.(type) {
+		case *ast.Ident:
+			v.Type = t.Name
+		case *ast.StructType:
+			if len(t.Fields.List) > 0 {
+				return nil, fmt.Errorf("%s.%s: anonymous structs must be empty", msg, v.Name)
+			}
+		default:
+			return nil, fmt.Errorf("%s.%s: variants must point to a type of the package or to struct{}", msg, v.Name)
+		}
+		doc := field.Doc
+		if doc == nil {
+			doc = field.Comment
+		}
+		if doc != nil {
+			v.Doc = strings.Split(strings.TrimSpace(doc.Text()), "\n")
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// responseType returns the type documented with "returns XResponse",
+// or else the <Variant>Response type of the package.
+func responseType(msg string, v variant, declared map[string]bool) (string, error) {
+	match := returnsPattern.FindStringSubmatch(strings.Join(v.Doc, " "))
+	if match != nil && (strings.Contains(match[1], ".") || declared[match[1]]) {
+		return match[1], nil
+	}
+	if declared[v.Name+"Response"] {
+		return v.Name + "Response", nil
+	}
+	if match != nil {
+		return "", fmt.Errorf("%s.%s: response type %s is not declared", msg, v.Name, match[1])
+	}
+	return "", fmt.Errorf("%s.%s: no response type, document it with \"returns %sResponse\"", msg, v.Name, v.Name)
+}
+
+// reflectTag returns the value of key in the struct tag, like reflect.StructTag.Get.
+func reflectTag(tag, key string) string {
+	for _, part := range strings.Fields(tag) {
+		if strings.HasPrefix(part, key+":") {
+			value, err := strconv.Unquote(part[len(key)+1:])
+			if err == nil {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+type importSpec struct {
+	// Name is empty when the package name is the last element of Path.
+	Name string
+	Path string
+}
+
+// SortedImports returns the imports of the client file sorted by path.
+func (c client) SortedImports() []importSpec {
+	var imports []importSpec
+	for name, path := range c.Imports {
+		if name == path[strings.LastIndexByte(path, '/')+1:] {
+			name = ""
+		}
+		imports = append(imports, importSpec{Name: name, Path: path})
+	}
+	sort.Slice(imports, func(i, j int) bool { return imports[i].Path < imports[j].Path })
+	return imports
+}
+
+var clientTemplate = template.Must(template.New("client").Parse(`// Code generated by cwclient. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .SortedImports}}
+	{{with .Name}}{{.}} {{end}}"{{.Path}}"
+{{- end}}
+)
+
+// {{.Name}} calls the contract at Contract, querying it through Querier
+// and building the messages which execute it.
+type {{.Name}} struct {
+	Querier  std.QuerierWrapper
+	Contract types.Addr
+}
+
+// New{{.Name}} returns a {{.Name}} of the contract at contract.
+func New{{.Name}}(querier std.QuerierWrapper, contract types.Addr) {{.Name}} {
+	return {{.Name}}{Querier: querier, Contract: contract}
+}
+{{range .Queries}}
+// Query{{.Name}} sends the {{$.QueryMsg}}.{{.Name}} query.
+{{- if .Doc}}
+//
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+{{- end}}
+func (c {{$.Name}}) Query{{.Name}}({{if .Type}}msg {{.Type}}{{end}}) (*{{.Response}}, error) {
+	resp := new({{.Response}})
+	err := c.Querier.QuerySmart(c.Contract.String(), &{{$.QueryMsg}}{ {{- .Name}}: {{if .Type}}&msg{{else}}&struct{}{}{{end -}} }, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+{{end}}
+{{- range .Executes}}
+// Execute{{.Name}} returns the message sending the {{$.ExecuteMsg}}.{{.Name}} message and funds.
+{{- if .Doc}}
+//
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+{{- end}}
+func (c {{$.Name}}) Execute{{.Name}}({{if .Type}}msg {{.Type}}, {{end}}funds []types.Coin) (types.CosmosMsg, error) {
+	bin, err := (&{{$.ExecuteMsg}}{ {{- .Name}}: {{if .Type}}&msg{{else}}&struct{}{}{{end -}} }).MarshalJSON()
+	if err != nil {
+		return types.CosmosMsg{}, err
+	}
+	return types.ExecuteMsg{ContractAddr: c.Contract, Msg: bin, Funds: funds}.ToMsg(), nil
+}
+{{end -}}
+`))
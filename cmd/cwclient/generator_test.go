@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// updateGolden makes TestGenerate rewrite the golden file instead of comparing against it.
+var updateGolden = flag.Bool("update-golden", false, "regenerate the golden files under testdata")
+
+var defaultConfig = Config{ExecuteMsg: "ExecuteMsg", QueryMsg: "QueryMsg", Client: "Client"}
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("testdata/contract/msg.go", defaultConfig)
+	require.NoError(t, err)
+
+	golden := "testdata/contract/msg_client.go.golden"
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(golden, src, 0o644))
+	}
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(src))
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	specs := map[string]struct {
+		src string
+		err string
+	}{
+		"no messages": {
+			src: "package contract\n\ntype InstantiateMsg struct{}\n",
+			err: "neither ExecuteMsg nor QueryMsg is declared",
+		},
+		"undocumented response": {
+			src: "package contract\n\ntype QueryMsg struct {\n\tCount *struct{}\n}\n",
+			err: `QueryMsg.Count: no response type, document it with "returns CountResponse"`,
+		},
+		"undeclared response": {
+			src: "package contract\n\ntype QueryMsg struct {\n\t// returns CounterResponse\n\tCount *struct{}\n}\n",
+			err: "QueryMsg.Count: response type CounterResponse is not declared",
+		},
+		"prose after returns": {
+			src: "package contract\n\ntype QueryMsg struct {\n\t// Balance returns the balance of an address\n\tBalance *struct{}\n}\n",
+			err: `QueryMsg.Balance: no response type, document it with "returns BalanceResponse"`,
+		},
+		"unimported response": {
+			src: "package contract\n\ntype QueryMsg struct {\n\t// returns types.CountResponse\n\tCount *struct{}\n}\n",
+			err: "QueryMsg.Count: package types of types.CountResponse is not imported",
+		},
+		"value variant": {
+			src: "package contract\n\ntype ExecuteMsg struct {\n\tReset struct{}\n}\n",
+			err: "ExecuteMsg.Reset: variants must be pointers",
+		},
+		"non empty anonymous struct": {
+			src: "package contract\n\ntype ExecuteMsg struct {\n\tReset *struct{ All bool }\n}\n",
+			err: "ExecuteMsg.Reset: anonymous structs must be empty",
+		},
+		"foreign variant": {
+			src: "package contract\n\nimport \"time\"\n\ntype ExecuteMsg struct {\n\tWait *time.Duration\n}\n",
+			err: "ExecuteMsg.Wait: variants must point to a type of the package or to struct{}",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "msg.go")
+			require.NoError(t, os.WriteFile(file, []byte(spec.src), 0o644))
+			_, err := Generate(file, defaultConfig)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), spec.err)
+		})
+	}
+}
+
+func TestOutputName(t *testing.T) {
+	require.Equal(t, "contract_client.go", OutputName("contract.go"))
+	require.Equal(t, "src/msg_client.go", OutputName("src/msg.go"))
+}
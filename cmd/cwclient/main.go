@@ -0,0 +1,56 @@
+// Command cwclient generates typed clients calling a contract from its messages.
+//
+// For each Go file given on the command line, cwclient reads the ExecuteMsg and
+// QueryMsg enum structs, whose fields are pointers to a message type or to struct{},
+// and writes the <file>_client.go file in the same package. The generated Client
+// has a QueryX method per QueryMsg variant, wrapping std.QuerierWrapper QuerySmart,
+// and an ExecuteX method per ExecuteMsg variant, returning the types.CosmosMsg
+// executing the contract with funds.
+//
+// The response of each query is the type named in its documentation with
+// "returns XResponse", defaulting to the XResponse type of the package:
+//
+//	type QueryMsg struct {
+//		// Count counts the items in the queue; returns CountResponse
+//		Count *struct{} `json:"count"`
+//	}
+//
+// The messages must have tinyjson MarshalJSON and UnmarshalJSON methods, so
+// cwclient is typically run next to tinyjson:
+//
+//	//go:generate cwclient contract.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	cfg := Config{}
+	flag.StringVar(&cfg.ExecuteMsg, "execute", "ExecuteMsg", "name of the execute messages enum struct")
+	flag.StringVar(&cfg.QueryMsg, "query", "QueryMsg", "name of the query messages enum struct")
+	flag.StringVar(&cfg.Client, "client", "Client", "name of the generated client type")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: cwclient [flags] file.go...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	for _, name := range flag.Args() {
+		src, err := Generate(name, cfg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(OutputName(name), src, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package contract
+
+import (
+	stdtypes "github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+type ExecuteMsg struct {
+	// Transfer sends amount tokens to recipient
+	Transfer *Transfer `json:"transfer,omitempty"`
+	Burn     *struct{} `json:"burn,omitempty"` // Burn burns all the tokens of the sender
+	Internal string    `json:"-"`
+}
+
+type QueryMsg struct {
+	// Balance returns a BalanceResponse
+	Balance *Balance `json:"balance,omitempty"`
+	// TokenInfo returns the name of the token
+	TokenInfo *struct{} `json:"token_info,omitempty"`
+	// Supply returns Supply, the total supply, which is not a declared type
+	Supply *struct{} `json:"supply,omitempty"`
+	// Funds returns `stdtypes.AllBalancesResponse`, the funds of the contract
+	Funds *struct{} `json:"funds,omitempty"`
+}
+
+type Transfer struct {
+	Recipient string `json:"recipient"`
+	Amount    uint64 `json:"amount"`
+}
+
+type Balance struct {
+	Address string `json:"address"`
+}
+
+var _ = stdtypes.Coin{}
@@ -0,0 +1,13 @@
+package contract
+
+type BalanceResponse struct {
+	Balance uint64 `json:"balance"`
+}
+
+type TokenInfoResponse struct {
+	Name string `json:"name"`
+}
+
+type SupplyResponse struct {
+	Supply uint64 `json:"supply"`
+}
@@ -14,6 +14,7 @@ function print_usage() {
   echo ""
   echo "Options: export CHECK=1 STRIP_FLOATS=1 to turn on extra features"
   echo "Options: export PAGES=30 to set 30 pages of memory"
+  echo "Options: export RELEASE=1 to compile std.Logger output away"
 }
 
 if [ "$#" -ne 1 ]; then
@@ -37,8 +38,13 @@ CONTRACT=$(basename "$DIR")
 WASM_FILE="/work/$CONTRACT.wasm"
 WAT_FILE="/work/$CONTRACT.wat"
 
+TAGS="cosmwasm tinyjson_nounsafe"
+if [ -n "${RELEASE+x}" ]; then
+  TAGS="$TAGS release"
+fi
+
 echo "Compiling $CONTRACT with tinygo..."
-tinygo build -tags "cosmwasm tinyjson_nounsafe" -no-debug -target wasi -o "$WASM_FILE" "${DIR}/main.go"
+tinygo build -tags "$TAGS" -no-debug -target wasi -o "$WASM_FILE" "${DIR}/main.go"
 
 # debug output
 ls -l "$WASM_FILE"
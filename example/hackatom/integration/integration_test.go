@@ -14,14 +14,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/cosmwasm-go/example/hackatom/src"
+	unitmocks "github.com/CosmWasm/cosmwasm-go/std/mock"
 	"github.com/CosmWasm/cosmwasm-go/systest"
 )
 
 var CONTRACT = filepath.Join("..", "hackatom.wasm")
 
-const VERIFIER = "verifies"
-const BENEFICIARY = "benefits"
-const FUNDER = "creator"
+var (
+	VERIFIER    = unitmocks.AddrMake("verifies")
+	BENEFICIARY = unitmocks.AddrMake("benefits")
+	FUNDER      = unitmocks.AddrMake("creator")
+)
 
 // this can be used for a quick setup if you don't have nay other requirements
 func defaultInit(t *testing.T, funds []types.Coin) *systest.Instance {
@@ -159,6 +162,92 @@ func TestQueryOther(t *testing.T) {
 	}
 }
 
+func TestQueryStaking(t *testing.T) {
+	deps := defaultInit(t, nil)
+	env := mocks.MockEnv()
+
+	validator := types.Validator{
+		Address:       "validator1",
+		Commission:    "0.05",
+		MaxCommission: "0.1",
+		MaxChangeRate: "0.01",
+	}
+	delegation := types.FullDelegation{
+		Delegator:          FUNDER,
+		Validator:          "validator1",
+		Amount:             types.NewCoin(100, "ustake"),
+		AccumulatedRewards: systest.NewCoins(5, "ustake"),
+		CanRedelegate:      types.NewCoin(80, "ustake"),
+	}
+	deps.SetQuerierStaking("ustake", []types.Validator{validator}, []types.FullDelegation{delegation})
+
+	raw, _, err := deps.Query(env, &src.QueryMsg{BondedDenom: &struct{}{}})
+	require.NoError(t, err)
+	var denom types.BondedDenomResponse
+	require.NoError(t, json.Unmarshal(raw, &denom))
+	assert.Equal(t, "ustake", denom.Denom)
+
+	raw, _, err = deps.Query(env, &src.QueryMsg{AllValidators: &struct{}{}})
+	require.NoError(t, err)
+	var validators types.AllValidatorsResponse
+	require.NoError(t, json.Unmarshal(raw, &validators))
+	assert.Equal(t, types.Validators{validator}, validators.Validators)
+
+	validatorCases := map[string]struct {
+		address  string
+		expected *types.Validator
+	}{
+		"existing": {"validator1", &validator},
+		"unknown":  {"validator2", nil},
+	}
+	for name, tc := range validatorCases {
+		t.Run("validator "+name, func(t *testing.T) {
+			raw, gas, err := deps.Query(env, &src.QueryMsg{Validator: &src.Validator{Address: tc.address}})
+			fmt.Printf("Query gas: %d\n", gas)
+			require.NoError(t, err)
+			var res types.ValidatorResponse
+			require.NoError(t, json.Unmarshal(raw, &res))
+			assert.Equal(t, tc.expected, res.Validator)
+		})
+	}
+
+	delegationsCases := map[string]struct {
+		delegator string
+		expected  types.Delegations
+	}{
+		"delegator": {FUNDER, types.Delegations{{Delegator: FUNDER, Validator: "validator1", Amount: delegation.Amount}}},
+		"unknown":   {BENEFICIARY, nil},
+	}
+	for name, tc := range delegationsCases {
+		t.Run("all delegations "+name, func(t *testing.T) {
+			raw, gas, err := deps.Query(env, &src.QueryMsg{AllDelegations: &src.AllDelegations{Delegator: tc.delegator}})
+			fmt.Printf("Query gas: %d\n", gas)
+			require.NoError(t, err)
+			var res types.AllDelegationsResponse
+			require.NoError(t, json.Unmarshal(raw, &res))
+			assert.ElementsMatch(t, tc.expected, res.Delegations)
+		})
+	}
+
+	delegationCases := map[string]struct {
+		validator string
+		expected  *types.FullDelegation
+	}{
+		"existing": {"validator1", &delegation},
+		"unknown":  {"validator2", nil},
+	}
+	for name, tc := range delegationCases {
+		t.Run("delegation "+name, func(t *testing.T) {
+			raw, gas, err := deps.Query(env, &src.QueryMsg{Delegation: &src.Delegation{Delegator: FUNDER, Validator: tc.validator}})
+			fmt.Printf("Query gas: %d\n", gas)
+			require.NoError(t, err)
+			var res types.DelegationResponse
+			require.NoError(t, json.Unmarshal(raw, &res))
+			assert.Equal(t, tc.expected, res.Delegation)
+		})
+	}
+}
+
 func TestQueryRecurse(t *testing.T) {
 	contractFunds := []types.Coin{
 		types.NewCoin(1000, "wei"),
@@ -208,7 +297,7 @@ func TestApiErrorInInit(t *testing.T) {
 	}
 	_, _, err := instance.Instantiate(env, info, initMsg)
 	require.Error(t, err)
-	assert.Equal(t, "Generic error: addr_canonicalize errored: human encoding too long", err.Error())
+	assert.Equal(t, "Generic error: addr_validate errored: error decoding bech32: bech32: mixed case", err.Error())
 }
 
 func TestRangeQuery(t *testing.T) {
@@ -222,13 +311,13 @@ func TestRangeQuery(t *testing.T) {
 	var state src.State
 	err = json.Unmarshal(data, &state)
 	require.NoError(t, err)
-	assert.Equal(t, VERIFIER, state.Verifier)
-	assert.Equal(t, FUNDER, state.Funder)
-	assert.Equal(t, BENEFICIARY, state.Beneficiary)
+	assert.Equal(t, VERIFIER, state.Verifier.String())
+	assert.Equal(t, FUNDER, state.Funder.String())
+	assert.Equal(t, BENEFICIARY, state.Beneficiary.String())
 }
 
 func TestMigrate(t *testing.T) {
-	const expectedVerifier = "gucci"
+	expectedVerifier := unitmocks.AddrMake("gucci")
 	i := defaultInit(t, nil)
 	res, gas, err := i.Migrate(mocks.MockEnv(), &src.MigrateMsg{Verifier: expectedVerifier})
 	require.NoError(t, err)
@@ -18,19 +18,18 @@ func Instantiate(deps *std.Deps, env types.Env, info types.MessageInfo, msg []by
 		return nil, err
 	}
 
-	// just verify these (later we save like that)
-	_, err = deps.Api.CanonicalAddress(initMsg.Verifier)
+	verifier, err := deps.Api.ValidateAddress(initMsg.Verifier)
 	if err != nil {
 		return nil, err
 	}
-	_, err = deps.Api.CanonicalAddress(initMsg.Beneficiary)
+	beneficiary, err := deps.Api.ValidateAddress(initMsg.Beneficiary)
 	if err != nil {
 		return nil, err
 	}
 
 	state := State{
-		Verifier:    initMsg.Verifier,
-		Beneficiary: initMsg.Beneficiary,
+		Verifier:    verifier,
+		Beneficiary: beneficiary,
 		Funder:      info.Sender,
 	}
 
@@ -55,7 +54,10 @@ func Migrate(deps *std.Deps, env types.Env, msg []byte) (*types.Response, error)
 	if err != nil {
 		return nil, err
 	}
-	state.Verifier = migrateMsg.Verifier
+	state.Verifier, err = deps.Api.ValidateAddress(migrateMsg.Verifier)
+	if err != nil {
+		return nil, err
+	}
 	err = SaveState(deps.Storage, state)
 	if err != nil {
 		return nil, err
@@ -139,7 +141,7 @@ func executeRelease(deps *std.Deps, env *types.Env, info *types.MessageInfo) (*t
 	if info.Sender != state.Verifier {
 		return nil, types.Unauthorized{}
 	}
-	amount, err := std.QuerierWrapper{deps.Querier}.QueryAllBalances(env.Contract.Address)
+	amount, err := std.QuerierWrapper{deps.Querier}.QueryAllBalances(env.Contract.Address.String())
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +154,7 @@ func executeRelease(deps *std.Deps, env *types.Env, info *types.MessageInfo) (*t
 	res := &types.Response{
 		Attributes: []types.EventAttribute{
 			{"action", "release"},
-			{"destination", state.Beneficiary},
+			{"destination", state.Beneficiary.String()},
 		},
 		Messages: []types.SubMsg{msg},
 	}
@@ -209,6 +211,16 @@ func Query(deps *std.Deps, env types.Env, data []byte) ([]byte, error) {
 		res, err = queryOtherBalance(deps, &env, msg.OtherBalance)
 	case msg.Recurse != nil:
 		return queryRecurse(deps, &env, msg.Recurse)
+	case msg.BondedDenom != nil:
+		res, err = queryBondedDenom(deps)
+	case msg.AllValidators != nil:
+		res, err = queryAllValidators(deps)
+	case msg.Validator != nil:
+		res, err = queryValidator(deps, msg.Validator)
+	case msg.AllDelegations != nil:
+		res, err = queryAllDelegations(deps, msg.AllDelegations)
+	case msg.Delegation != nil:
+		res, err = queryDelegation(deps, msg.Delegation)
 	case msg.TestRange != nil:
 		res, err = queryRange(deps, &env)
 	default:
@@ -228,7 +240,7 @@ func Query(deps *std.Deps, env types.Env, data []byte) ([]byte, error) {
 }
 
 func queryRecurse(deps *std.Deps, env *types.Env, recurse *Recurse) ([]byte, error) {
-	contractAddrBytes := []byte(env.Contract.Address)
+	contractAddrBytes := []byte(env.Contract.Address.String())
 
 	// perform work
 	var result [32]byte
@@ -253,7 +265,7 @@ func queryRecurse(deps *std.Deps, env *types.Env, recurse *Recurse) ([]byte, err
 	}
 
 	req, err := types.SmartQuery{
-		ContractAddr: env.Contract.Address,
+		ContractAddr: env.Contract.Address.String(),
 		Msg:          recurseBytes,
 	}.ToQuery().MarshalJSON()
 	if err != nil {
@@ -270,7 +282,7 @@ func queryVerifier(deps *std.Deps, env *types.Env) (*VerifierResponse, error) {
 	}
 
 	return &VerifierResponse{
-		Verifier: state.Verifier,
+		Verifier: state.Verifier.String(),
 	}, nil
 }
 
@@ -285,6 +297,61 @@ func queryOtherBalance(deps *std.Deps, env *types.Env, msg *OtherBalance) (*type
 	}, nil
 }
 
+func queryBondedDenom(deps *std.Deps) (*types.BondedDenomResponse, error) {
+	denom, err := std.QuerierWrapper{Querier: deps.Querier}.QueryBondedDenom()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BondedDenomResponse{
+		Denom: denom,
+	}, nil
+}
+
+func queryAllValidators(deps *std.Deps) (*types.AllValidatorsResponse, error) {
+	validators, err := std.QuerierWrapper{Querier: deps.Querier}.QueryAllValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AllValidatorsResponse{
+		Validators: validators,
+	}, nil
+}
+
+func queryValidator(deps *std.Deps, msg *Validator) (*types.ValidatorResponse, error) {
+	validator, err := std.QuerierWrapper{Querier: deps.Querier}.QueryValidator(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorResponse{
+		Validator: validator,
+	}, nil
+}
+
+func queryAllDelegations(deps *std.Deps, msg *AllDelegations) (*types.AllDelegationsResponse, error) {
+	delegations, err := std.QuerierWrapper{Querier: deps.Querier}.QueryAllDelegations(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AllDelegationsResponse{
+		Delegations: delegations,
+	}, nil
+}
+
+func queryDelegation(deps *std.Deps, msg *Delegation) (*types.DelegationResponse, error) {
+	delegation, err := std.QuerierWrapper{Querier: deps.Querier}.QueryDelegation(msg.Delegator, msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DelegationResponse{
+		Delegation: delegation,
+	}, nil
+}
+
 // this will use a range query and try to load up the state and ensure only one hit
 func queryRange(deps *std.Deps, env *types.Env) (*State, error) {
 	it := deps.Storage.Range(nil, nil, std.Ascending)
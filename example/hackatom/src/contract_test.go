@@ -22,9 +22,11 @@ func mustEncode(t *testing.T, msg interface{}) []byte {
 	return bz
 }
 
-const VERIFIER = "verifies"
-const BENEFICIARY = "benefits"
-const FUNDER = "creator"
+var (
+	VERIFIER    = mock.AddrMake("verifies")
+	BENEFICIARY = mock.AddrMake("benefits")
+	FUNDER      = mock.AddrMake("creator")
+)
 
 // this can be used for a quick setup if you don't have nay other requirements
 func defaultInit(t *testing.T, funds []types.Coin) *std.Deps {
@@ -83,7 +85,7 @@ func TestInitAndQuery(t *testing.T) {
 	err = recurseResp.UnmarshalJSON(data)
 	require.NoError(t, err)
 
-	expected := sha256.Sum256([]byte(env.Contract.Address))
+	expected := sha256.Sum256([]byte(env.Contract.Address.String()))
 	require.Equal(t, recurseResp.Hashed, hex.EncodeToString(expected[:]))
 }
 
@@ -133,7 +135,7 @@ func TestRelease(t *testing.T) {
 				require.Equal(t, 1, len(res.Messages))
 				msg := res.Messages[0]
 				expected := types.SendMsg{
-					ToAddress: BENEFICIARY,
+					ToAddress: mock.Addr(BENEFICIARY),
 					Amount:    tc.funds,
 				}.ToMsg()
 				assert.Equal(t, expected, msg.Msg)
@@ -160,7 +162,24 @@ func TestRangeQuery(t *testing.T) {
 	var state State
 	err = json.Unmarshal(data, &state)
 	require.NoError(t, err)
-	assert.Equal(t, VERIFIER, state.Verifier)
-	assert.Equal(t, FUNDER, state.Funder)
-	assert.Equal(t, BENEFICIARY, state.Beneficiary)
+	assert.Equal(t, VERIFIER, state.Verifier.String())
+	assert.Equal(t, FUNDER, state.Funder.String())
+	assert.Equal(t, BENEFICIARY, state.Beneficiary.String())
+}
+
+func TestErrorsFromDependencies(t *testing.T) {
+	errValidate := types.GenericError("addr_validate errored: invalid address")
+	deps := mock.Deps(nil)
+	mock.Inject(deps).FailValidateAddress(BENEFICIARY, errValidate)
+	_, err := Instantiate(deps, mock.Env(), mock.Info(FUNDER, nil), mustEncode(t, InitMsg{
+		Verifier:    VERIFIER,
+		Beneficiary: BENEFICIARY,
+	}))
+	require.Equal(t, errValidate, err)
+
+	errQuery := types.SystemError{Unknown: &types.Unknown{}}
+	deps = defaultInit(t, nil)
+	mock.Inject(deps).FailNextQuery(errQuery)
+	_, err = Execute(deps, mock.Env(), mock.Info(VERIFIER, nil), []byte(`{"release":{}}`))
+	require.Equal(t, errQuery, err)
 }
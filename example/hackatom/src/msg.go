@@ -23,6 +23,12 @@ type QueryMsg struct {
 	Verifier     *struct{}     `json:"verifier,omitempty"`
 	OtherBalance *OtherBalance `json:"other_balance,omitempty"`
 	Recurse      *Recurse      `json:"recurse,omitempty"`
+	// these run the staking queries of the QuerierWrapper inside the VM
+	BondedDenom    *struct{}       `json:"bonded_denom,omitempty"`
+	AllValidators  *struct{}       `json:"all_validators,omitempty"`
+	Validator      *Validator      `json:"validator,omitempty"`
+	AllDelegations *AllDelegations `json:"all_delegations,omitempty"`
+	Delegation     *Delegation     `json:"delegation,omitempty"`
 	// TODO: remove this when we have queue... this was for a quick and dirty test
 	TestRange *struct{} `json:"test_range,omitempty"`
 }
@@ -36,6 +42,19 @@ type Recurse struct {
 	Work  uint32 `json:"work,omitempty"`
 }
 
+type Validator struct {
+	Address string `json:"address"`
+}
+
+type AllDelegations struct {
+	Delegator string `json:"delegator"`
+}
+
+type Delegation struct {
+	Delegator string `json:"delegator"`
+	Validator string `json:"validator"`
+}
+
 type VerifierResponse struct {
 	Verifier string `json:"verifier"`
 }
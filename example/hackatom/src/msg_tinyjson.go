@@ -81,7 +81,73 @@ func (v *VerifierResponse) UnmarshalJSON(data []byte) error {
 func (v *VerifierResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(in *jlexer.Lexer, out *RecurseResponse) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(in *jlexer.Lexer, out *Validator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "address":
+			out.Address = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(out *jwriter.Writer, in Validator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Address))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Validator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Validator) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Validator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Validator) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(in *jlexer.Lexer, out *RecurseResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -112,7 +178,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(out *jwriter.Writer, in RecurseResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(out *jwriter.Writer, in RecurseResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -127,27 +193,27 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v RecurseResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v RecurseResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RecurseResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *RecurseResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc1(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(in *jlexer.Lexer, out *Recurse) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(in *jlexer.Lexer, out *Recurse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -180,7 +246,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(out *jwriter.Writer, in Recurse) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(out *jwriter.Writer, in Recurse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -206,27 +272,27 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v Recurse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v Recurse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Recurse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *Recurse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc2(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(in *jlexer.Lexer, out *QueryMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(in *jlexer.Lexer, out *QueryMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -275,6 +341,56 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(in *jl
 				}
 				(*out.Recurse).UnmarshalTinyJSON(in)
 			}
+		case "bonded_denom":
+			if in.IsNull() {
+				in.Skip()
+				out.BondedDenom = nil
+			} else {
+				if out.BondedDenom == nil {
+					out.BondedDenom = new(struct{})
+				}
+				tinyjsonF5cd6cf9Decode(in, out.BondedDenom)
+			}
+		case "all_validators":
+			if in.IsNull() {
+				in.Skip()
+				out.AllValidators = nil
+			} else {
+				if out.AllValidators == nil {
+					out.AllValidators = new(struct{})
+				}
+				tinyjsonF5cd6cf9Decode(in, out.AllValidators)
+			}
+		case "validator":
+			if in.IsNull() {
+				in.Skip()
+				out.Validator = nil
+			} else {
+				if out.Validator == nil {
+					out.Validator = new(Validator)
+				}
+				(*out.Validator).UnmarshalTinyJSON(in)
+			}
+		case "all_delegations":
+			if in.IsNull() {
+				in.Skip()
+				out.AllDelegations = nil
+			} else {
+				if out.AllDelegations == nil {
+					out.AllDelegations = new(AllDelegations)
+				}
+				(*out.AllDelegations).UnmarshalTinyJSON(in)
+			}
+		case "delegation":
+			if in.IsNull() {
+				in.Skip()
+				out.Delegation = nil
+			} else {
+				if out.Delegation == nil {
+					out.Delegation = new(Delegation)
+				}
+				(*out.Delegation).UnmarshalTinyJSON(in)
+			}
 		case "test_range":
 			if in.IsNull() {
 				in.Skip()
@@ -295,7 +411,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(out *jwriter.Writer, in QueryMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(out *jwriter.Writer, in QueryMsg) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -325,6 +441,56 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(out *j
 		}
 		(*in.Recurse).MarshalTinyJSON(out)
 	}
+	if in.BondedDenom != nil {
+		const prefix string = ",\"bonded_denom\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		tinyjsonF5cd6cf9Encode(out, *in.BondedDenom)
+	}
+	if in.AllValidators != nil {
+		const prefix string = ",\"all_validators\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		tinyjsonF5cd6cf9Encode(out, *in.AllValidators)
+	}
+	if in.Validator != nil {
+		const prefix string = ",\"validator\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Validator).MarshalTinyJSON(out)
+	}
+	if in.AllDelegations != nil {
+		const prefix string = ",\"all_delegations\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.AllDelegations).MarshalTinyJSON(out)
+	}
+	if in.Delegation != nil {
+		const prefix string = ",\"delegation\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Delegation).MarshalTinyJSON(out)
+	}
 	if in.TestRange != nil {
 		const prefix string = ",\"test_range\":"
 		if first {
@@ -341,25 +507,25 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v QueryMsg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v QueryMsg) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *QueryMsg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *QueryMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc3(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(l, v)
 }
 func tinyjsonF5cd6cf9Decode(in *jlexer.Lexer, out *struct{}) {
 	isTopLevel := in.IsStart()
@@ -396,7 +562,7 @@ func tinyjsonF5cd6cf9Encode(out *jwriter.Writer, in struct{}) {
 	_ = first
 	out.RawByte('}')
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(in *jlexer.Lexer, out *OtherBalance) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(in *jlexer.Lexer, out *OtherBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -427,7 +593,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(out *jwriter.Writer, in OtherBalance) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(out *jwriter.Writer, in OtherBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -443,27 +609,27 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v OtherBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v OtherBalance) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OtherBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *OtherBalance) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc4(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(in *jlexer.Lexer, out *MigrateMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(in *jlexer.Lexer, out *MigrateMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -494,7 +660,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(out *jwriter.Writer, in MigrateMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(out *jwriter.Writer, in MigrateMsg) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -509,27 +675,27 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v MigrateMsg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v MigrateMsg) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MigrateMsg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *MigrateMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc5(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(in *jlexer.Lexer, out *InitMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(in *jlexer.Lexer, out *InitMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -562,7 +728,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(out *jwriter.Writer, in InitMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(out *jwriter.Writer, in InitMsg) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -582,27 +748,27 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v InitMsg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v InitMsg) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *InitMsg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *InitMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc6(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(in *jlexer.Lexer, out *HandleMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc8(in *jlexer.Lexer, out *HandleMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -701,7 +867,7 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(in *jl
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(out *jwriter.Writer, in HandleMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc8(out *jwriter.Writer, in HandleMsg) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -777,23 +943,162 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v HandleMsg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v HandleMsg) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HandleMsg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *HandleMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc7(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc8(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc9(in *jlexer.Lexer, out *Delegation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "delegator":
+			out.Delegator = string(in.String())
+		case "validator":
+			out.Validator = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc9(out *jwriter.Writer, in Delegation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"delegator\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Delegator))
+	}
+	{
+		const prefix string = ",\"validator\":"
+		out.RawString(prefix)
+		out.String(string(in.Validator))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Delegation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Delegation) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Delegation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Delegation) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc9(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc10(in *jlexer.Lexer, out *AllDelegations) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "delegator":
+			out.Delegator = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc10(out *jwriter.Writer, in AllDelegations) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"delegator\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Delegator))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AllDelegations) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AllDelegations) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AllDelegations) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AllDelegations) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc10(l, v)
 }
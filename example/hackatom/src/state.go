@@ -4,14 +4,14 @@ import (
 	"errors"
 
 	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
 )
 
 // this is what we store
 type State struct {
-	// TODO: convert to canonical addresses when that is supported by ezjson
-	Verifier    string `json:"VERIFIER"`
-	Beneficiary string `json:"BENEFICIARY"`
-	Funder      string `json:"FUNDER"`
+	Verifier    types.Addr `json:"VERIFIER"`
+	Beneficiary types.Addr `json:"BENEFICIARY"`
+	Funder      types.Addr `json:"FUNDER"`
 }
 
 var StateKey = []byte("config")
@@ -35,11 +35,17 @@ func tinyjson4e1b3cc1DecodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc(in *jle
 		}
 		switch key {
 		case "VERIFIER":
-			out.Verifier = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Verifier).UnmarshalJSON(data))
+			}
 		case "BENEFICIARY":
-			out.Beneficiary = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Beneficiary).UnmarshalJSON(data))
+			}
 		case "FUNDER":
-			out.Funder = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Funder).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -57,17 +63,17 @@ func tinyjson4e1b3cc1EncodeGithubComCosmwasmCosmwasmGoExampleHackatomSrc(out *jw
 	{
 		const prefix string = ",\"VERIFIER\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Verifier))
+		out.Raw((in.Verifier).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"BENEFICIARY\":"
 		out.RawString(prefix)
-		out.String(string(in.Beneficiary))
+		out.Raw((in.Beneficiary).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"FUNDER\":"
 		out.RawString(prefix)
-		out.String(string(in.Funder))
+		out.Raw((in.Funder).MarshalJSON())
 	}
 	out.RawByte('}')
 }
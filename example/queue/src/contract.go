@@ -1,17 +1,19 @@
 //go:generate ../../../bin/tinyjson -all -snake_case contract.go
+//go:generate ../../../bin/cwclient contract.go
 package src
 
 import (
+	"encoding/binary"
 	"errors"
+	"math"
 
 	"github.com/sashaduke/cosmwasm-go/std"
+	"github.com/sashaduke/cosmwasm-go/std/storage"
 	"github.com/sashaduke/cosmwasm-go/std/types"
 )
 
-// FirstKey defines the value of the default key,
-// when no key is set in the contract so far.
-// NOTE: keys are [1]byte length but in KV they're [n]bytes.
-const FirstKey byte = 0
+// Queue is the storage.Deque holding the queue values.
+var Queue = storage.NewDeque("queue")
 
 // Item defines the state object of the queue values.
 type Item struct {
@@ -93,45 +95,41 @@ func Execute(deps *std.Deps, env types.Env, info types.MessageInfo, data []byte)
 }
 
 func executeDequeue(deps *std.Deps, _ types.Env, _ types.MessageInfo, _ *Dequeue) (*types.Response, error) {
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
 	resp := &types.Response{}
-	k, v, err := iter.Next()
-	// if queue is empty, then return an empty response to signal nothing was dequeued
+	item := new(Item)
+	found, err := Queue.PopFront(deps.Storage, item)
 	if err != nil {
+		return nil, err
+	}
+	// if queue is empty, then return an empty response to signal nothing was dequeued
+	if !found {
 		return resp, nil
 	}
-	// otherwise, delete the key and return the removed value
-	deps.Storage.Remove(k)
-	resp.Data = v
+	// otherwise return the removed value
+	resp.Data, err = item.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
 func executeEnqueue(deps *std.Deps, _ types.Env, _ types.MessageInfo, enqueue *Enqueue) (*types.Response, error) {
-	iter := deps.Storage.Range(nil, nil, std.Descending)
-	nextKey := []byte{FirstKey}
-	dbKey, _, err := iter.Next()
-	if err == nil {
-		nextKey[0] = dbKey[0] + 1
-	}
-	value, err := (Item{Value: enqueue.Value}).MarshalJSON()
+	err := Queue.PushBack(deps.Storage, &Item{Value: enqueue.Value})
 	if err != nil {
 		return nil, err
 	}
-	deps.Storage.Set(nextKey, value)
 	return &types.Response{}, nil
 }
 
 // Migrate executes queue contract's migration which consists in clearing
 // the state and writing three new values in the queue
 func Migrate(deps *std.Deps, _ types.Env, _ []byte) (*types.Response, error) {
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
 	// clear
-	for {
-		k, _, err := iter.Next()
+	for !Queue.IsEmpty(deps.Storage) {
+		_, err := Queue.PopBack(deps.Storage, new(Item))
 		if err != nil {
-			break
+			return nil, err
 		}
-		deps.Storage.Remove(k)
 	}
 	// add three values
 	for i := int32(100); i < 103; i++ {
@@ -167,36 +165,33 @@ func Query(deps *std.Deps, _ types.Env, msg []byte) ([]byte, error) {
 
 func queryReducer(deps *std.Deps) ([]byte, error) {
 	var counters [][2]int32
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
-	for {
-		_, value, err := iter.Next()
-		if err != nil {
-			break
-		}
+	err := std.ForEach(Queue.Iterate(deps.Storage, std.Ascending), func(_, value []byte) error {
 		item := new(Item)
 		if err := item.UnmarshalJSON(value); err != nil {
-			return nil, err
+			return err
 		}
 
 		sum := int32(0)
-		iter2 := deps.Storage.Range(nil, nil, std.Ascending)
-		for {
-			_, value2, err2 := iter2.Next()
-			if err2 != nil {
-				break
-			}
+		err := std.ForEach(Queue.Iterate(deps.Storage, std.Ascending), func(_, value2 []byte) error {
 			item2 := new(Item)
 			if err := item2.UnmarshalJSON(value2); err != nil {
-				return nil, err
+				return err
 			}
 			// skip second iterator items whose value is lower than the first
-			if item2.Value <= item.Value {
-				continue
+			if item2.Value > item.Value {
+				sum += item2.Value
 			}
-			sum += item2.Value
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
 		counters = append(counters, [2]int32{item.Value, sum})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	resp := &ReducerResponse{Counters: counters}
@@ -205,34 +200,19 @@ func queryReducer(deps *std.Deps) ([]byte, error) {
 
 func queryList(deps *std.Deps) ([]byte, error) {
 	// do empty
-	emptyIter := deps.Storage.Range([]byte("large"), []byte("large"), std.Ascending)
-	var empty []uint32
-	for {
-		k, _, err := emptyIter.Next()
-		if err != nil {
-			break
-		}
-		empty = append(empty, (uint32)(k[0]))
+	empty, err := listPositions(Queue.Range(deps.Storage, 20, 20, std.Ascending))
+	if err != nil {
+		return nil, err
 	}
 	// do early
-	earlyIter := deps.Storage.Range(nil, []byte{20}, std.Ascending)
-	var early []uint32
-	for {
-		k, _, err := earlyIter.Next()
-		if err != nil {
-			break
-		}
-		early = append(early, (uint32)(k[0]))
+	early, err := listPositions(Queue.Range(deps.Storage, 0, 20, std.Ascending))
+	if err != nil {
+		return nil, err
 	}
 	// do late
-	lateIter := deps.Storage.Range([]byte{20}, nil, std.Ascending)
-	var late []uint32
-	for {
-		k, _, err := lateIter.Next()
-		if err != nil {
-			break
-		}
-		late = append(late, (uint32)(k[0]))
+	late, err := listPositions(Queue.Range(deps.Storage, 20, math.MaxUint32, std.Ascending))
+	if err != nil {
+		return nil, err
 	}
 
 	resp := ListResponse{
@@ -249,17 +229,18 @@ func queryList(deps *std.Deps) ([]byte, error) {
 	return b, nil
 }
 
-func queryCount(deps *std.Deps) ([]byte, error) {
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
+// listPositions returns the queue positions yielded by iter.
+func listPositions(iter std.Iterator) ([]uint32, error) {
+	var positions []uint32
+	err := std.ForEach(iter, func(k, _ []byte) error {
+		positions = append(positions, binary.BigEndian.Uint32(k))
+		return nil
+	})
+	return positions, err
+}
 
-	var count uint32
-	for {
-		_, _, err := iter.Next()
-		if err != nil {
-			break
-		}
-		count++
-	}
+func queryCount(deps *std.Deps) ([]byte, error) {
+	count := Queue.Len(deps.Storage)
 
 	resp := CountResponse{Count: count}
 
@@ -273,18 +254,17 @@ func queryCount(deps *std.Deps) ([]byte, error) {
 
 func querySum(deps *std.Deps) ([]byte, error) {
 	var sum int32
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
-	for {
-		_, v, err := iter.Next()
-		if err != nil {
-			break
-		}
+	err := std.ForEach(Queue.Iterate(deps.Storage, std.Ascending), func(_, v []byte) error {
 		item := new(Item)
-		err = item.UnmarshalJSON(v)
+		err := item.UnmarshalJSON(v)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		sum += item.Value
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	resp := SumResponse{Sum: sum}
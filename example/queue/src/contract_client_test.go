@@ -0,0 +1,63 @@
+package src
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// contractQuerier answers the smart queries to contract by calling Query.
+type contractQuerier struct {
+	contract string
+	deps     *std.Deps
+}
+
+func (q contractQuerier) RawQuery(request []byte) ([]byte, error) {
+	query := types.QueryRequest{}
+	err := query.UnmarshalJSON(request)
+	if err != nil {
+		return nil, err
+	}
+	if query.Wasm == nil || query.Wasm.Smart == nil || query.Wasm.Smart.ContractAddr != q.contract {
+		return nil, errors.New("unexpected query")
+	}
+	return Query(q.deps, mock.Env(), query.Wasm.Smart.Msg)
+}
+
+func TestClient(t *testing.T) {
+	deps := mock.Deps(nil)
+	contract := mock.Addr("queue")
+	client := NewClient(std.QuerierWrapper{Querier: contractQuerier{contract: "queue", deps: deps}}, contract)
+
+	funds := []types.Coin{types.NewCoinFromUint64(10, "uatom")}
+	for _, value := range []int32{40, 2, 5} {
+		msg, err := client.ExecuteEnqueue(Enqueue{Value: value}, funds)
+		require.NoError(t, err)
+		require.NotNil(t, msg.Wasm)
+		require.NotNil(t, msg.Wasm.Execute)
+		require.Equal(t, contract, msg.Wasm.Execute.ContractAddr)
+		require.Equal(t, funds, msg.Wasm.Execute.Funds)
+		_, err = Execute(deps, mock.Env(), mock.Info("creator", funds), msg.Wasm.Execute.Msg)
+		require.NoError(t, err)
+	}
+	msg, err := client.ExecuteDequeue(Dequeue{}, nil)
+	require.NoError(t, err)
+	_, err = Execute(deps, mock.Env(), mock.Info("creator", nil), msg.Wasm.Execute.Msg)
+	require.NoError(t, err)
+
+	count, err := client.QueryCount()
+	require.NoError(t, err)
+	require.Equal(t, &CountResponse{Count: 2}, count)
+
+	sum, err := client.QuerySum()
+	require.NoError(t, err)
+	require.Equal(t, &SumResponse{Sum: 7}, sum)
+
+	_, err = NewClient(client.Querier, mock.Addr("other")).QueryCount()
+	require.EqualError(t, err, "unexpected query")
+}
@@ -25,36 +25,43 @@ func TestExecute_Enqueue(t *testing.T) {
 	// queue empty
 	_, err := Execute(deps, env, info, encode(t, ExecuteMsg{Enqueue: &Enqueue{Value: 5}}))
 	require.NoError(t, err)
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
-	k, v, err := iter.Next()
-	require.NoError(t, err)
-	require.Equal(t, k, []byte{FirstKey})
+	require.Equal(t, uint32(1), Queue.Len(deps.Storage))
 	got := new(Item)
-	require.NoError(t, got.UnmarshalJSON(v))
+	found, err := Queue.Back(deps.Storage, got)
+	require.NoError(t, err)
+	require.True(t, found)
 	// matching values
 	require.Equal(t, &Item{Value: 5}, got)
-	// no more results
-	_, _, err = iter.Next()
-	require.Error(t, err)
-	require.Equal(t, err.Error(), std.ErrIteratorDone.Error())
 
 	// queue not empty
 	_, err = Execute(deps, env, info, encode(t, ExecuteMsg{Enqueue: &Enqueue{Value: 8}}))
 	require.NoError(t, err)
-	iter = deps.Storage.Range(nil, nil, std.Ascending)
-	_, _, err = iter.Next() // skip first key
-	require.NoError(t, err)
-	k, v, err = iter.Next() // second key
-	require.NoError(t, err)
-	require.Equal(t, FirstKey+1, k[0]) // first key +1
+	require.Equal(t, uint32(2), Queue.Len(deps.Storage))
 	got = new(Item)
-	require.NoError(t, got.UnmarshalJSON(v))
+	found, err = Queue.Back(deps.Storage, got)
+	require.NoError(t, err)
+	require.True(t, found)
 	// matching values
 	require.Equal(t, &Item{Value: 8}, got)
-	// no more results
-	_, _, err = iter.Next()
-	require.Error(t, err)
-	require.Equal(t, err.Error(), std.ErrIteratorDone.Error())
+}
+
+func TestExecute_EnqueueMany(t *testing.T) {
+	deps := mock.Deps(nil)
+	env := mock.Env()
+	info := mock.Info("none", nil)
+
+	// more values than a single byte key can address
+	for i := int32(0); i < 300; i++ {
+		_, err := executeEnqueue(deps, env, info, &Enqueue{Value: i})
+		require.NoError(t, err)
+	}
+	for i := int32(0); i < 300; i++ {
+		resp, err := executeDequeue(deps, env, info, &Dequeue{})
+		require.NoError(t, err)
+		item := new(Item)
+		require.NoError(t, item.UnmarshalJSON(resp.Data))
+		require.Equal(t, i, item.Value)
+	}
 }
 
 func TestExecute_Dequeue(t *testing.T) {
@@ -89,16 +96,16 @@ func TestMigrate(t *testing.T) {
 	_, err = Migrate(deps, types.Env{}, nil)
 	require.NoError(t, err)
 
-	iter := deps.Storage.Range(nil, nil, std.Ascending)
+	iter := Queue.Iterate(deps.Storage, std.Ascending)
 	for i := 100; i < 103; i++ {
-		k, v, err := iter.Next()
-		require.NoError(t, err)
-		require.Equal(t, k[0], uint8(i-100))
-
 		item := new(Item)
-		require.NoError(t, item.UnmarshalJSON(v))
+		pos, err := iter.NextValue(item)
+		require.NoError(t, err)
+		require.Equal(t, uint32(i-100), pos)
 		require.Equal(t, item.Value, int32(i))
 	}
+	_, _, err = iter.Next()
+	require.Equal(t, std.ErrIteratorDone, err)
 }
 
 func TestQuery_Count(t *testing.T) {
@@ -0,0 +1,57 @@
+// Package crypto provides hash functions and helpers to verify signatures
+// made off-chain by Ethereum (personal_sign) and Cosmos (ADR-036) wallets
+// through the std.Api crypto functions.
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/CosmWasm/tinyjson/jwriter"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// ADR036SignDoc returns the amino JSON sign doc of an ADR-036 arbitrary message,
+// as signed by Keplr signArbitrary: a zero fee, empty chain id transaction
+// holding a single sign/MsgSignData of data by signer.
+func ADR036SignDoc(signer string, data []byte) []byte {
+	w := jwriter.Writer{}
+	w.RawString(`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":`)
+	w.String(base64.StdEncoding.EncodeToString(data))
+	w.RawString(`,"signer":`)
+	w.String(signer)
+	w.RawString(`}}],"sequence":"0"}`)
+	return w.Buffer.BuildBytes()
+}
+
+// CosmosAddress returns the canonical account address of the compressed 33 bytes
+// secp256k1 public key, the RIPEMD-160 hash of its SHA-256 hash.
+func CosmosAddress(pubKey []byte) ([]byte, error) {
+	if len(pubKey) != 33 {
+		return nil, InvalidSignatureErr{Reason: "public key must be compressed"}
+	}
+	hash := sha256.Sum256(pubKey)
+	return Ripemd160(hash[:]), nil
+}
+
+// VerifyADR036Signature reports whether data was signed as an ADR-036 arbitrary
+// message by signer, a bech32 account address. pubKey is the compressed secp256k1
+// public key of signer and signature the 64 bytes r || s returned by the wallet.
+// The signature is rejected if pubKey is not the key of signer.
+func VerifyADR036Signature(api std.Api, signer string, data, signature, pubKey []byte) (bool, error) {
+	signerAddr, err := api.CanonicalAddress(signer)
+	if err != nil {
+		return false, err
+	}
+	keyAddr, err := CosmosAddress(pubKey)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(signerAddr, keyAddr) {
+		return false, nil
+	}
+	hash := sha256.Sum256(ADR036SignDoc(signer, data))
+	return api.VerifySecp256k1Signature(hash[:], signature, pubKey)
+}
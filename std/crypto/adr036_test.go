@@ -0,0 +1,74 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+func TestADR036SignDoc(t *testing.T) {
+	doc := ADR036SignDoc("cosmos1ujtnemf6jmfm995j000qdry064n5lq854gfe3j", []byte("hello"))
+	expected := `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"cosmos1ujtnemf6jmfm995j000qdry064n5lq854gfe3j"}}],"sequence":"0"}`
+	require.Equal(t, expected, string(doc))
+}
+
+func TestCosmosAddress(t *testing.T) {
+	// vector of the secp256k1 key tests of tendermint and cosmos-sdk, where the
+	// address is given as the base58check encoding 1CKZ9Nx4zgds8tU7nJHotKSDr4a9bYJCa3
+	pubKey, err := hex.DecodeString("02950e1cdfcb133d6024109fd489f734eeb4502418e538c28481f22bce276f248c")
+	require.NoError(t, err)
+	addr, err := CosmosAddress(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "7c2bb42a8be69791ec763e51f5a49bcd41e82237", hex.EncodeToString(addr))
+
+	_, err = CosmosAddress(pubKey[1:])
+	require.Equal(t, InvalidSignatureErr{Reason: "public key must be compressed"}, err)
+}
+
+func TestVerifyADR036Signature(t *testing.T) {
+	api := mock.APIWithPrefix("cosmos")
+	privKey, err := secp256k1.NewPrivateKey(secp256k1.S256())
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	addr, err := CosmosAddress(pubKey)
+	require.NoError(t, err)
+	signerAddr, err := api.HumanAddress(addr)
+	require.NoError(t, err)
+	signer := signerAddr.String()
+
+	data := []byte(`{"action":"claim"}`)
+	sign := func(signer string, data []byte) []byte {
+		hash := sha256.Sum256(ADR036SignDoc(signer, data))
+		sig, err := privKey.Sign(hash[:])
+		require.NoError(t, err)
+		signature := make([]byte, 64)
+		sig.R.FillBytes(signature[:32])
+		sig.S.FillBytes(signature[32:])
+		return signature
+	}
+	signature := sign(signer, data)
+
+	ok, err := VerifyADR036Signature(api, signer, data, signature, pubKey)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// other data
+	ok, err = VerifyADR036Signature(api, signer, []byte(`{"action":"burn"}`), signature, pubKey)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// pubKey does not belong to signer
+	other := mock.AddrMakeWithPrefix("cosmos", "other")
+	ok, err = VerifyADR036Signature(api, other, data, sign(other, data), pubKey)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// invalid signer
+	_, err = VerifyADR036Signature(api, "juno1invalid", data, signature, pubKey)
+	require.Error(t, err)
+}
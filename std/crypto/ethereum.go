@@ -0,0 +1,114 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// EthereumAddressLength is the length of an Ethereum address in bytes.
+const EthereumAddressLength = 20
+
+// InvalidEthereumAddressErr is returned when parsing a string which is not a hex encoded Ethereum address.
+type InvalidEthereumAddressErr struct {
+	Address string
+}
+
+func (e InvalidEthereumAddressErr) Error() string {
+	return "Invalid Ethereum address: " + e.Address
+}
+
+// InvalidSignatureErr is returned when a signature does not have the expected format.
+type InvalidSignatureErr struct {
+	Reason string
+}
+
+func (e InvalidSignatureErr) Error() string {
+	return "Invalid signature: " + e.Reason
+}
+
+// EthereumMessageHash returns the digest signed by personal_sign (EIP-191 version 0x45),
+// the Keccak-256 hash of message prefixed with "\x19Ethereum Signed Message:\n" and its length.
+func EthereumMessageHash(message []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return Keccak256([]byte(prefix), message)
+}
+
+// EthereumAddress returns the address of the uncompressed 65 bytes secp256k1 public key,
+// as returned by std.Api RecoverSecp256k1PubKey.
+func EthereumAddress(pubKey []byte) ([]byte, error) {
+	if len(pubKey) != 65 || pubKey[0] != 0x04 {
+		return nil, InvalidSignatureErr{Reason: "public key must be uncompressed"}
+	}
+	return Keccak256(pubKey[1:])[32-EthereumAddressLength:], nil
+}
+
+// ParseEthereumAddress decodes an address in 0x prefixed hex form.
+// Mixed case addresses must have a valid EIP-55 checksum.
+func ParseEthereumAddress(address string) ([]byte, error) {
+	if len(address) != 2+2*EthereumAddressLength || (address[:2] != "0x" && address[:2] != "0X") {
+		return nil, InvalidEthereumAddressErr{Address: address}
+	}
+	addr, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return nil, InvalidEthereumAddressErr{Address: address}
+	}
+	digits := address[2:]
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && ChecksumEthereumAddress(addr) != "0x"+digits {
+		return nil, InvalidEthereumAddressErr{Address: address}
+	}
+	return addr, nil
+}
+
+// ChecksumEthereumAddress encodes addr in 0x prefixed hex form with the EIP-55 mixed case checksum.
+func ChecksumEthereumAddress(addr []byte) string {
+	digits := []byte(hex.EncodeToString(addr))
+	hash := Keccak256(digits)
+	for i, c := range digits {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			digits[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(digits)
+}
+
+// RecoverEthereumAddress returns the address which signed message with personal_sign.
+// signature is the 65 bytes r || s || v returned by wallets, v being 27 or 28 (or 0 or 1).
+func RecoverEthereumAddress(api std.Api, message, signature []byte) ([]byte, error) {
+	if len(signature) != 65 {
+		return nil, InvalidSignatureErr{Reason: "expected 65 bytes"}
+	}
+	v := signature[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, InvalidSignatureErr{Reason: "invalid recovery id"}
+	}
+	pubKey, err := api.RecoverSecp256k1PubKey(EthereumMessageHash(message), signature[:64], std.Secp256k1RecoveryParam(v))
+	if err != nil {
+		return nil, err
+	}
+	return EthereumAddress(pubKey)
+}
+
+// VerifyEthereumSignature reports whether message was signed with personal_sign by signer,
+// a 0x prefixed hex address. See RecoverEthereumAddress for the signature format.
+func VerifyEthereumSignature(api std.Api, message, signature []byte, signer string) (bool, error) {
+	expected, err := ParseEthereumAddress(signer)
+	if err != nil {
+		return false, err
+	}
+	recovered, err := RecoverEthereumAddress(api, message, signature)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(expected, recovered), nil
+}
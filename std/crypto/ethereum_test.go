@@ -0,0 +1,102 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+// web3.js accounts.sign example
+const (
+	web3PrivKey   = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	web3Address   = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	web3Message   = "Some data"
+	web3Hash      = "1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655"
+	web3Signature = "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+)
+
+func TestEthereumMessageHash(t *testing.T) {
+	require.Equal(t, web3Hash, hex.EncodeToString(EthereumMessageHash([]byte(web3Message))))
+}
+
+func TestEthereumAddress(t *testing.T) {
+	privKeyBytes, err := hex.DecodeString(web3PrivKey)
+	require.NoError(t, err)
+	_, pubKey := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKeyBytes)
+
+	addr, err := EthereumAddress(pubKey.SerializeUncompressed())
+	require.NoError(t, err)
+	require.Equal(t, web3Address, ChecksumEthereumAddress(addr))
+
+	_, err = EthereumAddress(pubKey.SerializeCompressed())
+	require.Equal(t, InvalidSignatureErr{Reason: "public key must be uncompressed"}, err)
+}
+
+func TestParseEthereumAddress(t *testing.T) {
+	// EIP-55 examples
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		addr, err := ParseEthereumAddress(address)
+		require.NoError(t, err)
+		require.Equal(t, address, ChecksumEthereumAddress(addr))
+	}
+
+	// single case addresses carry no checksum
+	_, err := ParseEthereumAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	require.NoError(t, err)
+	_, err = ParseEthereumAddress("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED")
+	require.NoError(t, err)
+
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", // wrong checksum
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+		"0xZaAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	} {
+		_, err := ParseEthereumAddress(address)
+		require.Equal(t, InvalidEthereumAddressErr{Address: address}, err)
+	}
+}
+
+func TestVerifyEthereumSignature(t *testing.T) {
+	api := mock.API()
+	signature, err := hex.DecodeString(web3Signature)
+	require.NoError(t, err)
+
+	addr, err := RecoverEthereumAddress(api, []byte(web3Message), signature)
+	require.NoError(t, err)
+	require.Equal(t, web3Address, ChecksumEthereumAddress(addr))
+
+	ok, err := VerifyEthereumSignature(api, []byte(web3Message), signature, web3Address)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// v may also be given as the recovery param
+	rawV := append(append([]byte{}, signature[:64]...), signature[64]-27)
+	ok, err = VerifyEthereumSignature(api, []byte(web3Message), rawV, web3Address)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyEthereumSignature(api, []byte("Other data"), signature, web3Address)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = VerifyEthereumSignature(api, []byte(web3Message), signature, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = VerifyEthereumSignature(api, []byte(web3Message), signature[:64], web3Address)
+	require.Equal(t, InvalidSignatureErr{Reason: "expected 65 bytes"}, err)
+
+	badV := append(append([]byte{}, signature[:64]...), 29)
+	_, err = VerifyEthereumSignature(api, []byte(web3Message), badV, web3Address)
+	require.Equal(t, InvalidSignatureErr{Reason: "invalid recovery id"}, err)
+}
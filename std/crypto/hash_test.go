@@ -0,0 +1,46 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeccak256(t *testing.T) {
+	cases := map[string]string{
+		"":    "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		"abc": "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+	}
+	for input, expected := range cases {
+		require.Equal(t, expected, hex.EncodeToString(Keccak256([]byte(input))), input)
+	}
+
+	// the result does not depend on how the input is split, including across blocks
+	data := []byte(strings.Repeat("cosmwasm", 50))
+	expected := Keccak256(data)
+	for _, split := range []int{0, 1, 135, 136, 137, len(data)} {
+		require.Equal(t, expected, Keccak256(data[:split], data[split:]), "split %d", split)
+	}
+}
+
+func TestRipemd160(t *testing.T) {
+	data := make([]byte, 120)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	cases := map[string]struct {
+		data     []byte
+		expected string
+	}{
+		"empty":          {data: nil, expected: "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+		"abc":            {data: []byte("abc"), expected: "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		"message digest": {data: []byte("message digest"), expected: "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+		"two blocks":     {data: data, expected: "b89cdc109009f1982c8b34fca446953584d3f6c4"},
+		"million a":      {data: []byte(strings.Repeat("a", 1000000)), expected: "52783243c1697bdbe16d37f97f68f08325dc1528"},
+	}
+	for name, tc := range cases {
+		require.Equal(t, tc.expected, hex.EncodeToString(Ripemd160(tc.data)), name)
+	}
+}
@@ -0,0 +1,168 @@
+// Package cw2 implements the cw2 contract info standard: every contract stores
+// its name and version under the "contract_info" key, so that tooling and
+// other contracts can identify it through a raw query.
+package cw2
+
+import (
+	"github.com/CosmWasm/tinyjson/jlexer"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/storage"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// ContractInfoKey is the storage key of the ContractVersion.
+const ContractInfoKey = "contract_info"
+
+var contractInfo = storage.NewItem(ContractInfoKey)
+
+// ContractMismatchErr is returned when migrating a contract which was
+// stored by another contract.
+type ContractMismatchErr struct {
+	Stored   string
+	Expected string
+}
+
+func (e ContractMismatchErr) Error() string {
+	return "Cannot migrate from contract " + e.Stored + " to " + e.Expected
+}
+
+// NotNewerErr is returned when migrating to a version which is not newer than the stored one.
+type NotNewerErr struct {
+	Stored  string
+	Version string
+}
+
+func (e NotNewerErr) Error() string {
+	return "Cannot migrate from version " + e.Stored + " to " + e.Version
+}
+
+var _ std.JSONType = (*ContractVersion)(nil)
+
+// ContractVersion identifies the code of a contract.
+type ContractVersion struct {
+	// Contract is the crate name of the contract, like crates.io:cw20-base.
+	Contract string `json:"contract"`
+	// Version is the semantic version of the contract.
+	Version string `json:"version"`
+}
+
+// MarshalJSON encodes v byte for byte like the cw2 Rust implementation,
+// which escapes only the characters required by JSON.
+func (v ContractVersion) MarshalJSON() ([]byte, error) {
+	out := make([]byte, 0, len(v.Contract)+len(v.Version)+27)
+	out = append(out, `{"contract":`...)
+	out = appendString(out, v.Contract)
+	out = append(out, `,"version":`...)
+	out = appendString(out, v.Version)
+	return append(out, '}'), nil
+}
+
+// UnmarshalJSON implements std.JSONType.
+func (v *ContractVersion) UnmarshalJSON(data []byte) error {
+	in := jlexer.Lexer{Data: data}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "contract":
+			v.Contract = in.String()
+		case "version":
+			v.Version = in.String()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+	return in.Error()
+}
+
+// SetContractVersion stores the contract name and version,
+// it is meant to be called at instantiation and after migrations.
+func SetContractVersion(storage std.Storage, contract, version string) error {
+	return contractInfo.Save(storage, &ContractVersion{Contract: contract, Version: version})
+}
+
+// GetContractVersion loads the stored ContractVersion.
+// A types.NotFound error is returned if none was stored.
+func GetContractVersion(storage std.ReadonlyStorage) (ContractVersion, error) {
+	v := ContractVersion{}
+	err := contractInfo.Load(storage, &v)
+	return v, err
+}
+
+// QueryContractVersion reads the ContractVersion of another contract with a raw query.
+// A types.NotFound error is returned if the contract stored none.
+func QueryContractVersion(querier std.QuerierWrapper, contractAddr string) (ContractVersion, error) {
+	v := ContractVersion{}
+	data, err := querier.QueryRaw(contractAddr, []byte(ContractInfoKey))
+	if err != nil {
+		return v, err
+	}
+	if data == nil {
+		return v, types.NotFound{Kind: ContractInfoKey}
+	}
+	err = v.UnmarshalJSON(data)
+	return v, err
+}
+
+// AssertUpgrade checks that the stored ContractVersion belongs to contract
+// and is older than version, it is meant to be called by migrate handlers
+// before touching state.
+func AssertUpgrade(storage std.ReadonlyStorage, contract, version string) error {
+	stored, err := GetContractVersion(storage)
+	if err != nil {
+		return err
+	}
+	if stored.Contract != contract {
+		return ContractMismatchErr{Stored: stored.Contract, Expected: contract}
+	}
+	storedVersion, err := ParseVersion(stored.Version)
+	if err != nil {
+		return err
+	}
+	newVersion, err := ParseVersion(version)
+	if err != nil {
+		return err
+	}
+	if !storedVersion.LessThan(newVersion) {
+		return NotNewerErr{Stored: stored.Version, Version: version}
+	}
+	return nil
+}
+
+const hexChars = "0123456789abcdef"
+
+// appendString appends s as a JSON string, escaping like serde_json.
+func appendString(out []byte, s string) []byte {
+	out = append(out, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"':
+			out = append(out, '\\', '"')
+		case '\\':
+			out = append(out, '\\', '\\')
+		case '\b':
+			out = append(out, '\\', 'b')
+		case '\f':
+			out = append(out, '\\', 'f')
+		case '\n':
+			out = append(out, '\\', 'n')
+		case '\r':
+			out = append(out, '\\', 'r')
+		case '\t':
+			out = append(out, '\\', 't')
+		default:
+			if c < 0x20 {
+				out = append(out, '\\', 'u', '0', '0', hexChars[c>>4], hexChars[c&0xf])
+				continue
+			}
+			out = append(out, c)
+		}
+	}
+	return append(out, '"')
+}
@@ -0,0 +1,85 @@
+package cw2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// rawQuerier answers raw queries to contract with the values of its storage.
+type rawQuerier struct {
+	contract string
+	storage  std.Storage
+}
+
+func (q rawQuerier) RawQuery(request []byte) ([]byte, error) {
+	query := types.QueryRequest{}
+	err := query.UnmarshalJSON(request)
+	if err != nil {
+		return nil, err
+	}
+	if query.Wasm == nil || query.Wasm.Raw == nil || query.Wasm.Raw.ContractAddr != q.contract {
+		return nil, errors.New("unexpected query")
+	}
+	return q.storage.Get(query.Wasm.Raw.Key), nil
+}
+
+func TestContractVersion(t *testing.T) {
+	store := mock.Storage()
+
+	_, err := GetContractVersion(store)
+	require.Equal(t, types.NotFound{Kind: ContractInfoKey}, err)
+	querier := std.QuerierWrapper{Querier: rawQuerier{contract: "contract", storage: store}}
+	_, err = QueryContractVersion(querier, "contract")
+	require.Equal(t, types.NotFound{Kind: ContractInfoKey}, err)
+
+	require.NoError(t, SetContractVersion(store, "crates.io:cw20-base", "0.10.3"))
+	// the layout matches cw2
+	require.Equal(t, `{"contract":"crates.io:cw20-base","version":"0.10.3"}`, string(store.Get([]byte("contract_info"))))
+
+	v, err := GetContractVersion(store)
+	require.NoError(t, err)
+	require.Equal(t, ContractVersion{Contract: "crates.io:cw20-base", Version: "0.10.3"}, v)
+
+	v, err = QueryContractVersion(querier, "contract")
+	require.NoError(t, err)
+	require.Equal(t, ContractVersion{Contract: "crates.io:cw20-base", Version: "0.10.3"}, v)
+}
+
+func TestContractVersion_JSON(t *testing.T) {
+	// only the characters required by JSON are escaped, like serde_json does
+	v := ContractVersion{Contract: "a<b>&\"c\\\n\b\x01", Version: "1.0.0"}
+	data, err := v.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{"contract":"a<b>&\"c\\\n\b\u0001","version":"1.0.0"}`, string(data))
+
+	decoded := ContractVersion{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	require.Equal(t, v, decoded)
+
+	// unknown fields are ignored
+	require.NoError(t, decoded.UnmarshalJSON([]byte(`{"version":"2.0.0","extra":[1],"contract":"x"}`)))
+	require.Equal(t, ContractVersion{Contract: "x", Version: "2.0.0"}, decoded)
+
+	require.Error(t, decoded.UnmarshalJSON([]byte(`{"contract":1}`)))
+	require.Error(t, decoded.UnmarshalJSON(nil))
+}
+
+func TestAssertUpgrade(t *testing.T) {
+	store := mock.Storage()
+	require.NoError(t, SetContractVersion(store, "crates.io:cw20-base", "0.10.3"))
+
+	require.NoError(t, AssertUpgrade(store, "crates.io:cw20-base", "0.11.0"))
+	require.Equal(t, ContractMismatchErr{Stored: "crates.io:cw20-base", Expected: "crates.io:cw721-base"},
+		AssertUpgrade(store, "crates.io:cw721-base", "1.0.0"))
+	require.Equal(t, NotNewerErr{Stored: "0.10.3", Version: "0.10.3"},
+		AssertUpgrade(store, "crates.io:cw20-base", "0.10.3"))
+	require.Equal(t, NotNewerErr{Stored: "0.10.3", Version: "0.10.3-rc.1"},
+		AssertUpgrade(store, "crates.io:cw20-base", "0.10.3-rc.1"))
+	require.Equal(t, InvalidVersionErr{Version: "latest"}, AssertUpgrade(store, "crates.io:cw20-base", "latest"))
+}
@@ -0,0 +1,174 @@
+package cw2
+
+import (
+	"strconv"
+	"strings"
+)
+
+// InvalidVersionErr is returned when parsing a string which is not a semantic version.
+type InvalidVersionErr struct {
+	Version string
+}
+
+func (e InvalidVersionErr) Error() string {
+	return "Invalid semantic version: " + e.Version
+}
+
+// Version is a semantic version as defined by https://semver.org.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+	// Pre are the dot separated pre-release identifiers.
+	Pre []string
+	// Build is the build metadata, it is ignored by comparisons.
+	Build string
+}
+
+// ParseVersion parses a semantic version like 1.2.3-rc.1+build.5.
+func ParseVersion(s string) (Version, error) {
+	v := Version{}
+	rest := s
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Build, false) {
+			return Version{}, InvalidVersionErr{Version: s}
+		}
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		pre := rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(pre, true) {
+			return Version{}, InvalidVersionErr{Version: s}
+		}
+		v.Pre = strings.Split(pre, ".")
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return Version{}, InvalidVersionErr{Version: s}
+	}
+	numbers := [3]uint64{}
+	for i, part := range parts {
+		if !isNumeric(part) || (len(part) > 1 && part[0] == '0') {
+			return Version{}, InvalidVersionErr{Version: s}
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Version{}, InvalidVersionErr{Version: s}
+		}
+		numbers[i] = n
+	}
+	v.Major, v.Minor, v.Patch = numbers[0], numbers[1], numbers[2]
+	return v, nil
+}
+
+// String returns the version in its canonical form.
+func (v Version) String() string {
+	s := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + "." + strconv.FormatUint(v.Patch, 10)
+	if len(v.Pre) != 0 {
+		s += "-" + strings.Join(v.Pre, ".")
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 if v is respectively lower than, equal to or greater than o,
+// following the semantic versioning precedence rules.
+func (v Version) Compare(o Version) int {
+	if c := compareUint(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+	// a version without pre-release identifiers has precedence.
+	switch {
+	case len(v.Pre) == 0 && len(o.Pre) == 0:
+		return 0
+	case len(v.Pre) == 0:
+		return 1
+	case len(o.Pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Pre) && i < len(o.Pre); i++ {
+		if c := compareIdentifier(v.Pre[i], o.Pre[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.Pre)), uint64(len(o.Pre)))
+}
+
+// LessThan reports whether v has a lower precedence than o.
+func (v Version) LessThan(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareIdentifier compares pre-release identifiers: numeric identifiers are
+// compared numerically and have a lower precedence than alphanumeric ones.
+func compareIdentifier(a, b string) int {
+	aNumeric, bNumeric := isNumeric(a), isNumeric(b)
+	switch {
+	case aNumeric && bNumeric:
+		if c := compareUint(uint64(len(a)), uint64(len(b))); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// validIdentifiers reports whether s is made of dot separated, non empty
+// identifiers of ASCII alphanumerics and hyphens. Numeric pre-release
+// identifiers must not have leading zeroes.
+func validIdentifiers(s string, pre bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		for i := 0; i < len(id); i++ {
+			c := id[i]
+			if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+				return false
+			}
+		}
+		if pre && isNumeric(id) && len(id) > 1 && id[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package cw2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("1.22.333-rc.1+build.5")
+	require.NoError(t, err)
+	require.Equal(t, Version{Major: 1, Minor: 22, Patch: 333, Pre: []string{"rc", "1"}, Build: "build.5"}, v)
+	require.Equal(t, "1.22.333-rc.1+build.5", v.String())
+
+	v, err = ParseVersion("0.0.0")
+	require.NoError(t, err)
+	require.Equal(t, Version{}, v)
+
+	for _, invalid := range []string{"", "1", "1.2", "1.2.3.4", "01.2.3", "1.2.x", "1.2.3-", "1.2.3-rc..1", "1.2.3-01", "1.2.3+", "1.2.3-rc_1", "v1.2.3", "1.2.-3"} {
+		_, err = ParseVersion(invalid)
+		require.Equal(t, InvalidVersionErr{Version: invalid}, err, invalid)
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	// ordered as in the semver specification
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "1.9.0", "1.10.0", "2.0.0",
+	}
+	for i := range ordered {
+		a, err := ParseVersion(ordered[i])
+		require.NoError(t, err)
+		require.Equal(t, 0, a.Compare(a))
+		for j := i + 1; j < len(ordered); j++ {
+			b, err := ParseVersion(ordered[j])
+			require.NoError(t, err)
+			require.Equal(t, -1, a.Compare(b), "%s < %s", ordered[i], ordered[j])
+			require.Equal(t, 1, b.Compare(a), "%s > %s", ordered[j], ordered[i])
+			require.True(t, a.LessThan(b))
+		}
+	}
+
+	// build metadata is ignored
+	a, _ := ParseVersion("1.0.0+a")
+	b, _ := ParseVersion("1.0.0+b")
+	require.Equal(t, 0, a.Compare(b))
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"unsafe"
 
+	"github.com/CosmWasm/cosmwasm-go/std/internal/addr"
 	"github.com/CosmWasm/cosmwasm-go/std/types"
 )
 
@@ -201,7 +202,7 @@ func (api ExternalApi) CanonicalAddress(human string) (types.CanonicalAddress, e
 	return canoAddress, nil
 }
 
-func (api ExternalApi) HumanAddress(canonical types.CanonicalAddress) (string, error) {
+func (api ExternalApi) HumanAddress(canonical types.CanonicalAddress) (types.Addr, error) {
 	canonPtr := C.malloc(C.ulong(REGION_HEAD_SIZE))
 	regionCanon := TranslateToRegion(canonical, uintptr(canonPtr))
 
@@ -212,15 +213,15 @@ func (api ExternalApi) HumanAddress(canonical types.CanonicalAddress) (string, e
 
 	if ret != 0 {
 		msg := TranslateToString(uintptr(ret))
-		return "", types.GenericError("addr_humanize errored: " + msg)
+		return types.Addr{}, types.GenericError("addr_humanize errored: " + msg)
 	}
 
 	humanAddress := TranslateToString(uintptr(regionHuman))
 
-	return humanAddress, nil
+	return addr.New(humanAddress), nil
 }
 
-func (api ExternalApi) ValidateAddress(human string) error {
+func (api ExternalApi) ValidateAddress(human string) (types.Addr, error) {
 	humanAddr := []byte(human)
 	humanPtr := C.malloc(C.ulong(REGION_HEAD_SIZE))
 	regionHuman := TranslateToRegion(humanAddr, uintptr(humanPtr))
@@ -230,9 +231,9 @@ func (api ExternalApi) ValidateAddress(human string) error {
 
 	if ret != 0 {
 		msg := TranslateToString(uintptr(ret))
-		return types.GenericError("addr_validate errored: " + msg)
+		return types.Addr{}, types.GenericError("addr_validate errored: " + msg)
 	}
-	return nil
+	return addr.New(human), nil
 }
 
 func (api ExternalApi) Debug(msg string) {
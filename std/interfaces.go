@@ -65,10 +65,11 @@ type Api interface {
 	CanonicalAddress(human types.HumanAddress) (types.CanonicalAddress, error)
 
 	// HumanAddress converts the canonical address to a human-readable representation.
-	HumanAddress(canonical types.CanonicalAddress) (types.HumanAddress, error)
+	HumanAddress(canonical types.CanonicalAddress) (types.Addr, error)
 
 	// ValidateAddress validates the human-readable address performing CanonicalAddress and HumanAddress conversions.
-	ValidateAddress(human types.HumanAddress) error
+	// Returns the validated address, which can be stored and used in messages.
+	ValidateAddress(human types.HumanAddress) (types.Addr, error)
 
 	// Debug sends the debug log message to the host which can either process or ignore it.
 	Debug(msg string)
@@ -136,6 +137,107 @@ func (q QuerierWrapper) QueryBalance(addr string, denom string) (types.Coin, err
 	return qres.Amount, err
 }
 
+// QueryBondedDenom returns the denom of the staking token.
+func (q QuerierWrapper) QueryBondedDenom() (string, error) {
+	qres := types.BondedDenomResponse{}
+	err := q.Query(types.BondedDenomQuery{}, &qres)
+	return qres.Denom, err
+}
+
+// QueryAllValidators returns the active validators.
+func (q QuerierWrapper) QueryAllValidators() ([]types.Validator, error) {
+	qres := types.AllValidatorsResponse{}
+	err := q.Query(types.AllValidatorsQuery{}, &qres)
+	if err != nil {
+		return nil, err
+	}
+	return qres.Validators, nil
+}
+
+// QueryValidator returns the active validator with the given operator address
+// (e.g. cosmosvaloper1...), or nil if there is none.
+func (q QuerierWrapper) QueryValidator(addr string) (*types.Validator, error) {
+	query := types.ValidatorQuery{
+		Address: addr,
+	}
+	qres := types.ValidatorResponse{}
+	err := q.Query(query, &qres)
+	if err != nil {
+		return nil, err
+	}
+	return qres.Validator, nil
+}
+
+// QueryAllDelegations returns the delegations of delegator.
+// Use QueryDelegation to get the rewards and redelegation details of a delegation.
+func (q QuerierWrapper) QueryAllDelegations(delegator string) ([]types.Delegation, error) {
+	query := types.AllDelegationsQuery{
+		Delegator: delegator,
+	}
+	qres := types.AllDelegationsResponse{}
+	err := q.Query(query, &qres)
+	if err != nil {
+		return nil, err
+	}
+	return qres.Delegations, nil
+}
+
+// QueryDelegation returns the delegation of delegator to validator,
+// or nil if there is none.
+func (q QuerierWrapper) QueryDelegation(delegator, validator string) (*types.FullDelegation, error) {
+	query := types.DelegationQuery{
+		Delegator: delegator,
+		Validator: validator,
+	}
+	qres := types.DelegationResponse{}
+	err := q.Query(query, &qres)
+	if err != nil {
+		return nil, err
+	}
+	return qres.Delegation, nil
+}
+
+// QueryPortID returns the IBC port of the contract.
+// It fails if the contract does not implement the IBC entry points.
+func (q QuerierWrapper) QueryPortID() (string, error) {
+	qres := types.PortIDResponse{}
+	err := q.Query(types.PortIDQuery{}, &qres)
+	return qres.PortID, err
+}
+
+// QueryListChannels returns the IBC channels bound to port,
+// or to the port of the contract if port is empty.
+func (q QuerierWrapper) QueryListChannels(port string) ([]types.IBCChannel, error) {
+	query := types.ListChannelsQuery{
+		PortID: port,
+	}
+	qres := types.ListChannelsResponse{}
+	err := q.Query(query, &qres)
+	if err != nil {
+		return nil, err
+	}
+	return qres.Channels, nil
+}
+
+// QueryChannel returns the IBC channel with the given id bound to port,
+// or to the port of the contract if port is empty.
+// It returns a types.NotFound error if there is no such channel.
+func (q QuerierWrapper) QueryChannel(port, channel string) (types.IBCChannel, error) {
+	query := types.ChannelQuery{
+		PortID:    port,
+		ChannelID: channel,
+	}
+	qres := types.ChannelResponse{}
+	err := q.Query(query, &qres)
+	if err != nil {
+		return types.IBCChannel{}, err
+	}
+	if qres.Channel == nil {
+		return types.IBCChannel{}, types.NotFound{Kind: "IBC channel " + channel}
+	}
+	return *qres.Channel, nil
+}
+
 func (q QuerierWrapper) QuerySmart(addr string, msg JSONType, resp JSONType) error {
 	bin, err := msg.MarshalJSON()
 	if err != nil {
@@ -149,20 +251,47 @@ func (q QuerierWrapper) QuerySmart(addr string, msg JSONType, resp JSONType) err
 	return err
 }
 
-func (q QuerierWrapper) QueryRaw(addr string, key []byte, resp JSONType) error {
+// QueryRaw returns the value stored under key by the contract at addr,
+// or nil if there is none.
+func (q QuerierWrapper) QueryRaw(addr string, key []byte) ([]byte, error) {
 	query := types.RawQuery{
 		ContractAddr: addr,
 		Key:          key,
 	}
-	err := q.Query(query, resp)
-	return err
+	binQuery, err := query.ToQuery().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	// raw queries return the stored value as is, not a JSON response
+	data, err := q.Querier.RawQuery(binQuery)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return data, nil
 }
 
-func (q QuerierWrapper) QueryContractInfo(addr string) (*types.ContractInfo, error) {
+// QueryContractInfo returns the metadata of the contract at addr.
+func (q QuerierWrapper) QueryContractInfo(addr string) (*types.ContractInfoResponse, error) {
 	query := types.ContractInfoQuery{
 		ContractAddr: addr,
 	}
-	qres := new(types.ContractInfo)
+	qres := new(types.ContractInfoResponse)
 	err := q.Query(query, qres)
-	return qres, err
+	if err != nil {
+		return nil, err
+	}
+	return qres, nil
+}
+
+// QueryCodeInfo returns the metadata of the code with the given id.
+func (q QuerierWrapper) QueryCodeInfo(codeID uint64) (*types.CodeInfoResponse, error) {
+	query := types.CodeInfoQuery{
+		CodeID: codeID,
+	}
+	qres := new(types.CodeInfoResponse)
+	err := q.Query(query, qres)
+	if err != nil {
+		return nil, err
+	}
+	return qres, nil
 }
@@ -0,0 +1,52 @@
+// Package addr holds the implementation of types.Addr, so that only std
+// and std/mock can create addresses without validating them.
+package addr
+
+import (
+	"github.com/CosmWasm/tinyjson/jlexer"
+	"github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// Addr is the implementation of types.Addr, see its documentation.
+type Addr struct {
+	addr string
+}
+
+// New returns an Addr without validating addr.
+// It is meant for std.Api implementations and the mocks.
+func New(addr string) Addr {
+	return Addr{addr: addr}
+}
+
+// String returns the address.
+func (a Addr) String() string {
+	return a.addr
+}
+
+// IsDefined reports whether a is not the zero Addr.
+// It implements tinyjson.Optional, so that empty addresses
+// are left out of fields tagged with omitempty.
+func (a Addr) IsDefined() bool {
+	return a.addr != ""
+}
+
+// MarshalJSON encodes the address as a JSON string.
+func (a Addr) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	w.String(a.addr)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON decodes the address from a JSON string,
+// null decodes to the zero Addr.
+func (a *Addr) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	if r.IsNull() {
+		r.Skip()
+		a.addr = ""
+	} else {
+		a.addr = r.String()
+	}
+	r.Consumed()
+	return r.Error()
+}
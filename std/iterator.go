@@ -0,0 +1,123 @@
+package std
+
+// ClosableIterator is an Iterator holding resources which must be released
+// when the iteration stops before ErrIteratorDone is returned.
+type ClosableIterator interface {
+	Iterator
+	// Close releases the resources of the iterator, it can be called more than once.
+	Close()
+}
+
+// CloseIterator closes iter if it is a ClosableIterator, it is a no-op otherwise.
+func CloseIterator(iter Iterator) {
+	closable, ok := iter.(ClosableIterator)
+	if ok {
+		closable.Close()
+	}
+}
+
+// ForEach calls fn for every entry of iter, until the iterator is done or fn
+// returns an error. The error returned by fn or by the iterator is returned,
+// ErrIteratorDone is not considered an error. iter is closed on return.
+func ForEach(iter Iterator, fn func(key, value []byte) error) error {
+	defer CloseIterator(iter)
+	for {
+		key, value, err := iter.Next()
+		if err == ErrIteratorDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = fn(key, value)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Collect returns the keys and values of at most limit entries of iter,
+// a limit of 0 collects all the entries. iter is closed on return.
+func Collect(iter Iterator, limit uint32) (keys, values [][]byte, err error) {
+	defer CloseIterator(iter)
+	for limit == 0 || uint32(len(keys)) < limit {
+		key, value, err := iter.Next()
+		if err == ErrIteratorDone {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	return keys, values, nil
+}
+
+// Keys returns the keys of all the entries of iter. iter is closed on return.
+func Keys(iter Iterator) ([][]byte, error) {
+	keys, _, err := Collect(iter, 0)
+	return keys, err
+}
+
+// Values returns the values of all the entries of iter. iter is closed on return.
+func Values(iter Iterator) ([][]byte, error) {
+	_, values, err := Collect(iter, 0)
+	return values, err
+}
+
+// Count returns the number of entries of iter. iter is closed on return.
+func Count(iter Iterator) (uint32, error) {
+	var count uint32
+	err := ForEach(iter, func(_, _ []byte) error {
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// First returns the first entry of iter, or a nil key and value if iter has
+// no entries. iter is closed on return.
+func First(iter Iterator) (key, value []byte, err error) {
+	defer CloseIterator(iter)
+	key, value, err = iter.Next()
+	if err == ErrIteratorDone {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+var _ ClosableIterator = (*filterIterator)(nil)
+
+// filterIterator yields the entries of iter for which keep returns true.
+type filterIterator struct {
+	iter Iterator
+	keep func(key, value []byte) bool
+}
+
+// Filter returns an Iterator yielding only the entries of iter for which
+// keep returns true. Closing it closes iter.
+func Filter(iter Iterator, keep func(key, value []byte) bool) ClosableIterator {
+	return &filterIterator{iter: iter, keep: keep}
+}
+
+// Next implements Iterator.
+func (f *filterIterator) Next() (key, value []byte, err error) {
+	for {
+		key, value, err = f.iter.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if f.keep(key, value) {
+			return key, value, nil
+		}
+	}
+}
+
+// Close implements ClosableIterator.
+func (f *filterIterator) Close() {
+	CloseIterator(f.iter)
+}
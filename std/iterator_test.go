@@ -0,0 +1,128 @@
+package std_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+var errFailing = errors.New("failing")
+
+// failingIterator yields its entries, then fails instead of being done.
+type failingIterator struct {
+	keys   []string
+	closed bool
+}
+
+func (f *failingIterator) Next() (key, value []byte, err error) {
+	if len(f.keys) == 0 {
+		return nil, nil, errFailing
+	}
+	key = []byte(f.keys[0])
+	f.keys = f.keys[1:]
+	return key, key, nil
+}
+
+func (f *failingIterator) Close() {
+	f.closed = true
+}
+
+func iteratorStorage() std.Storage {
+	store := mock.Storage()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		store.Set([]byte(key), []byte("value-"+key))
+	}
+	return store
+}
+
+func toStrings(b [][]byte) []string {
+	s := make([]string, len(b))
+	for i := range b {
+		s[i] = string(b[i])
+	}
+	return s
+}
+
+func TestIteratorHelpers(t *testing.T) {
+	store := iteratorStorage()
+
+	keys, err := std.Keys(store.Range(nil, nil, std.Ascending))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d"}, toStrings(keys))
+
+	values, err := std.Values(store.Range(nil, nil, std.Descending))
+	require.NoError(t, err)
+	require.Equal(t, []string{"value-d", "value-c", "value-b", "value-a"}, toStrings(values))
+
+	keys, values, err = std.Collect(store.Range([]byte("b"), nil, std.Ascending), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c"}, toStrings(keys))
+	require.Equal(t, []string{"value-b", "value-c"}, toStrings(values))
+
+	count, err := std.Count(store.Range(nil, []byte("c"), std.Ascending))
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), count)
+
+	key, value, err := std.First(store.Range(nil, nil, std.Descending))
+	require.NoError(t, err)
+	require.Equal(t, "d", string(key))
+	require.Equal(t, "value-d", string(value))
+
+	key, value, err = std.First(store.Range([]byte("x"), nil, std.Ascending))
+	require.NoError(t, err)
+	require.Nil(t, key)
+	require.Nil(t, value)
+
+	filtered := std.Filter(store.Range(nil, nil, std.Ascending), func(key, _ []byte) bool {
+		return key[0] != 'b'
+	})
+	keys, err = std.Keys(filtered)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "d"}, toStrings(keys))
+
+	// closed iterators release the storage, so it can be written again
+	store.Set([]byte("e"), []byte("value-e"))
+	count, err = std.Count(store.Range(nil, nil, std.Ascending))
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), count)
+}
+
+func TestIteratorHelpers_Errors(t *testing.T) {
+	iter := &failingIterator{keys: []string{"a", "b"}}
+	_, err := std.Keys(iter)
+	require.Equal(t, errFailing, err)
+	require.True(t, iter.closed)
+
+	iter = &failingIterator{keys: []string{"a", "b"}}
+	keys, _, err := std.Collect(iter, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, toStrings(keys))
+	require.True(t, iter.closed)
+
+	_, err = std.Count(&failingIterator{})
+	require.Equal(t, errFailing, err)
+
+	_, _, err = std.First(&failingIterator{})
+	require.Equal(t, errFailing, err)
+
+	iter = &failingIterator{keys: []string{"a"}}
+	err = std.ForEach(iter, func(_, _ []byte) error {
+		return errors.New("stop")
+	})
+	require.EqualError(t, err, "stop")
+	require.True(t, iter.closed)
+
+	iter = &failingIterator{keys: []string{"a"}}
+	filtered := std.Filter(iter, func(_, _ []byte) bool { return false })
+	_, _, err = filtered.Next()
+	require.Equal(t, errFailing, err)
+	filtered.Close()
+	require.True(t, iter.closed)
+
+	// iterators without resources can be closed too
+	std.CloseIterator(std.Filter(&failingIterator{}, nil))
+}
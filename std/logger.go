@@ -0,0 +1,127 @@
+package std
+
+import (
+	"strconv"
+	"strings"
+)
+
+// LogLevel is the severity of a message written by a Logger.
+type LogLevel uint8
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the upper case name of the level, as written in log lines.
+func (l LogLevel) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return "LEVEL(" + strconv.Itoa(int(l)) + ")"
+	}
+}
+
+// Logger writes leveled messages with key/value fields through Api.Debug,
+// one line per message formatted as:
+//
+//	[prefix] LEVEL message key=value key="quoted value"
+//
+// Building with the release tag turns every Logger method writing a message
+// into a no-op, so that the formatting code is left out of the wasm binary
+// and no gas is spent on debug output.
+type Logger struct {
+	api    Api
+	prefix string
+	level  LogLevel
+	fields string
+}
+
+// NewLogger returns a Logger writing messages of any level to api.
+func NewLogger(api Api) Logger {
+	return Logger{api: api}
+}
+
+// WithPrefix returns a Logger starting its lines with [prefix],
+// typically the contract name.
+func (l Logger) WithPrefix(prefix string) Logger {
+	l.prefix = prefix
+	return l
+}
+
+// WithLevel returns a Logger dropping the messages below level.
+func (l Logger) WithLevel(level LogLevel) Logger {
+	l.level = level
+	return l
+}
+
+// With returns a Logger adding the key/value pairs keyvals to every line.
+func (l Logger) With(keyvals ...string) Logger {
+	if !loggingEnabled {
+		return l
+	}
+	l.fields += encodeFields(keyvals)
+	return l
+}
+
+// Debug writes msg at LevelDebug with the key/value pairs keyvals.
+func (l Logger) Debug(msg string, keyvals ...string) {
+	l.log(LevelDebug, msg, keyvals)
+}
+
+// Info writes msg at LevelInfo with the key/value pairs keyvals.
+func (l Logger) Info(msg string, keyvals ...string) {
+	l.log(LevelInfo, msg, keyvals)
+}
+
+// Warn writes msg at LevelWarn with the key/value pairs keyvals.
+func (l Logger) Warn(msg string, keyvals ...string) {
+	l.log(LevelWarn, msg, keyvals)
+}
+
+// Error writes msg at LevelError with the key/value pairs keyvals.
+func (l Logger) Error(msg string, keyvals ...string) {
+	l.log(LevelError, msg, keyvals)
+}
+
+func (l Logger) log(level LogLevel, msg string, keyvals []string) {
+	if !loggingEnabled || level < l.level || l.api == nil {
+		return
+	}
+	line := level.String() + " " + msg + l.fields + encodeFields(keyvals)
+	if l.prefix != "" {
+		line = "[" + l.prefix + "] " + line
+	}
+	l.api.Debug(line)
+}
+
+// encodeFields encodes the key/value pairs keyvals as " key=value" fields.
+// A key without value is written with the (MISSING) value.
+func encodeFields(keyvals []string) string {
+	fields := ""
+	for i := 0; i < len(keyvals); i += 2 {
+		value := "(MISSING)"
+		if i+1 < len(keyvals) {
+			value = keyvals[i+1]
+		}
+		fields += " " + keyvals[i] + "=" + quoteValue(value)
+	}
+	return fields
+}
+
+// quoteValue quotes the values which could not be told apart from the next field.
+func quoteValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " =\"\t\n\r") {
+		return strconv.Quote(value)
+	}
+	return value
+}
@@ -0,0 +1,7 @@
+//go:build !release
+// +build !release
+
+package std
+
+// loggingEnabled is true unless building with the release tag.
+const loggingEnabled = true
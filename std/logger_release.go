@@ -0,0 +1,7 @@
+//go:build release
+// +build release
+
+package std
+
+// loggingEnabled is false in release builds, so that the compiler removes the Logger output.
+const loggingEnabled = false
@@ -0,0 +1,19 @@
+//go:build release
+// +build release
+
+package std_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+func TestLogger_Release(t *testing.T) {
+	api := mock.API()
+	std.NewLogger(api).WithPrefix("cw20").Error("dropped", "key", "value")
+	require.Empty(t, mock.Logs(api).Lines())
+}
@@ -0,0 +1,44 @@
+//go:build !release
+// +build !release
+
+package std_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+func TestLogger(t *testing.T) {
+	api := mock.API()
+	logger := std.NewLogger(api)
+
+	logger.Debug("start")
+	logger.Info("transfer", "from", "alice", "memo", "two words", "empty", "", "quote", `a"b`)
+	logger.Error("odd", "key")
+	logger.WithPrefix("cw20").With("height", "12345").Warn("low balance", "denom", "uatom")
+
+	require.Equal(t, []string{
+		`DEBUG start`,
+		`INFO transfer from=alice memo="two words" empty="" quote="a\"b"`,
+		`ERROR odd key=(MISSING)`,
+		`[cw20] WARN low balance height=12345 denom=uatom`,
+	}, mock.Logs(api).Lines())
+}
+
+func TestLogger_Level(t *testing.T) {
+	api := mock.API()
+	logger := std.NewLogger(api).WithLevel(std.LevelWarn)
+
+	logger.Debug("dropped")
+	logger.Info("dropped")
+	logger.Warn("kept")
+	logger.Error("kept")
+	require.Equal(t, []string{"WARN kept", "ERROR kept"}, mock.Logs(api).Lines())
+
+	// a zero Logger drops everything
+	std.Logger{}.Error("dropped")
+}
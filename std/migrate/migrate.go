@@ -0,0 +1,138 @@
+// Package migrate runs versioned state migrations from the migrate entrypoint.
+//
+// The schema version of the contract state is stored under VersionKey.
+// Migration steps are registered in order, the step at index i brings the
+// state from version i to version i+1, so the latest version is the number of steps.
+//
+//	var migrator = migrate.New(
+//		migrate.Step{Name: "add_owner", Migrate: addOwner},
+//		migrate.Step{Name: "split_balances", Migrate: splitBalances},
+//	)
+//
+//	func Instantiate(deps *std.Deps, env types.Env, info types.MessageInfo, msg []byte) (*types.Response, error) {
+//		migrator.SaveLatest(deps.Storage)
+//		...
+//	}
+//
+//	//export migrate
+//	func migrate(envPtr, msgPtr uint32) unsafe.Pointer {
+//		return std.DoMigrate(migrator.Migrate, envPtr, msgPtr)
+//	}
+package migrate
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// VersionKey is the storage key of the schema version.
+const VersionKey = "schema_version"
+
+var errInvalidVersion = errors.New("migrate: invalid stored schema version")
+
+// DowngradeErr is returned when the stored schema version is newer
+// than the latest version known by the contract.
+type DowngradeErr struct {
+	Stored uint32
+	Latest uint32
+}
+
+func (e DowngradeErr) Error() string {
+	return "Cannot migrate from schema version " + strconv.FormatUint(uint64(e.Stored), 10) +
+		" to older version " + strconv.FormatUint(uint64(e.Latest), 10)
+}
+
+// StepErr wraps the error returned by a migration step.
+type StepErr struct {
+	Step string
+	Err  error
+}
+
+func (e StepErr) Error() string {
+	return "Migration step " + e.Step + " failed: " + e.Err.Error()
+}
+
+func (e StepErr) Unwrap() error {
+	return e.Err
+}
+
+// Step is a single migration of the contract state.
+type Step struct {
+	// Name identifies the step in the response attributes and errors.
+	Name string
+	// Migrate brings the state to the next schema version.
+	// msg is the message the migrate entrypoint was called with.
+	Migrate func(deps *std.Deps, env types.Env, msg []byte) error
+}
+
+// Migrator runs the migration steps which were not applied yet to the contract state.
+type Migrator struct {
+	steps []Step
+}
+
+// New returns a Migrator running the provided steps in order.
+func New(steps ...Step) Migrator {
+	return Migrator{steps: steps}
+}
+
+// Latest returns the latest schema version, which is the number of steps.
+func (m Migrator) Latest() uint32 {
+	return uint32(len(m.steps))
+}
+
+// Version returns the stored schema version, contracts which never stored one are at version 0.
+func (m Migrator) Version(storage std.ReadonlyStorage) (uint32, error) {
+	value := storage.Get([]byte(VersionKey))
+	if value == nil {
+		return 0, nil
+	}
+	if len(value) != 4 {
+		return 0, errInvalidVersion
+	}
+	return binary.BigEndian.Uint32(value), nil
+}
+
+// SaveLatest stores the latest schema version, it is meant to be called at
+// instantiation as a new contract does not need any migration.
+func (m Migrator) SaveLatest(storage std.Storage) {
+	saveVersion(storage, m.Latest())
+}
+
+// Migrate runs the pending steps in order and stores the reached version.
+// It has the signature of std.MigrateFunc, the migrate message is passed to every step.
+// The response attributes report the versions and the names of the steps which ran.
+func (m Migrator) Migrate(deps *std.Deps, env types.Env, msg []byte) (*types.Response, error) {
+	version, err := m.Version(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+	latest := m.Latest()
+	if version > latest {
+		return nil, DowngradeErr{Stored: version, Latest: latest}
+	}
+
+	attributes := []types.EventAttribute{
+		{Key: "action", Value: "migrate"},
+		{Key: "from_version", Value: strconv.FormatUint(uint64(version), 10)},
+		{Key: "to_version", Value: strconv.FormatUint(uint64(latest), 10)},
+	}
+	for _, step := range m.steps[version:] {
+		err = step.Migrate(deps, env, msg)
+		if err != nil {
+			return nil, StepErr{Step: step.Name, Err: err}
+		}
+		attributes = append(attributes, types.EventAttribute{Key: "migration_step", Value: step.Name})
+	}
+	saveVersion(deps.Storage, latest)
+	return &types.Response{Attributes: attributes}, nil
+}
+
+func saveVersion(storage std.Storage, version uint32) {
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, version)
+	storage.Set([]byte(VersionKey), value)
+}
@@ -0,0 +1,119 @@
+package migrate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// recordStep returns a step appending its name to the ran steps.
+func recordStep(t *testing.T, name string, ran *[]string) Step {
+	return Step{
+		Name: name,
+		Migrate: func(deps *std.Deps, env types.Env, _ []byte) error {
+			require.Equal(t, mock.Env(), env)
+			*ran = append(*ran, name)
+			return nil
+		},
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	deps := mock.Deps(nil)
+	var ran []string
+
+	// no version stored: every step runs
+	v1 := New(recordStep(t, "one", &ran))
+	resp, err := v1.Migrate(deps, mock.Env(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"one"}, ran)
+	require.Equal(t, []types.EventAttribute{
+		{Key: "action", Value: "migrate"},
+		{Key: "from_version", Value: "0"},
+		{Key: "to_version", Value: "1"},
+		{Key: "migration_step", Value: "one"},
+	}, resp.Attributes)
+
+	// only the pending steps run
+	ran = nil
+	v3 := New(recordStep(t, "one", &ran), recordStep(t, "two", &ran), recordStep(t, "three", &ran))
+	resp, err = v3.Migrate(deps, mock.Env(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"two", "three"}, ran)
+	require.Equal(t, []types.EventAttribute{
+		{Key: "action", Value: "migrate"},
+		{Key: "from_version", Value: "1"},
+		{Key: "to_version", Value: "3"},
+		{Key: "migration_step", Value: "two"},
+		{Key: "migration_step", Value: "three"},
+	}, resp.Attributes)
+	version, err := v3.Version(deps.Storage)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), version)
+
+	// running again is a no-op
+	ran = nil
+	_, err = v3.Migrate(deps, mock.Env(), nil)
+	require.NoError(t, err)
+	require.Empty(t, ran)
+
+	// downgrades are refused
+	_, err = v1.Migrate(deps, mock.Env(), nil)
+	require.Equal(t, DowngradeErr{Stored: 3, Latest: 1}, err)
+	require.EqualError(t, err, "Cannot migrate from schema version 3 to older version 1")
+}
+
+func TestMigrator_SaveLatest(t *testing.T) {
+	deps := mock.Deps(nil)
+	var ran []string
+	m := New(recordStep(t, "one", &ran), recordStep(t, "two", &ran))
+
+	m.SaveLatest(deps.Storage)
+	_, err := m.Migrate(deps, mock.Env(), nil)
+	require.NoError(t, err)
+	require.Empty(t, ran)
+}
+
+func TestMigrator_StepError(t *testing.T) {
+	deps := mock.Deps(nil)
+	failure := errors.New("failure")
+	m := New(Step{
+		Name: "failing",
+		Migrate: func(_ *std.Deps, _ types.Env, _ []byte) error {
+			return failure
+		},
+	})
+
+	_, err := m.Migrate(deps, mock.Env(), nil)
+	require.ErrorIs(t, err, failure)
+	require.EqualError(t, err, "Migration step failing failed: failure")
+	version, err := m.Version(deps.Storage)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), version)
+}
+
+func TestMigrator_Msg(t *testing.T) {
+	deps := mock.Deps(nil)
+	msg := []byte(`{"owner":"alice"}`)
+	var received [][]byte
+	step := func(name string) Step {
+		return Step{
+			Name: name,
+			Migrate: func(_ *std.Deps, _ types.Env, msg []byte) error {
+				received = append(received, msg)
+				return nil
+			},
+		}
+	}
+
+	// every pending step receives the migrate message
+	m := New(step("one"), step("two"))
+	_, err := m.Migrate(deps, mock.Env(), msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{msg, msg}, received)
+}
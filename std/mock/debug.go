@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// DebugLog captures the messages sent to the Debug function of a mocked std.Api,
+// such as the lines written by a std.Logger.
+type DebugLog struct {
+	lines []string
+}
+
+// Lines returns the captured messages, one per Debug call, in call order.
+func (l *DebugLog) Lines() []string {
+	return l.lines
+}
+
+// Reset drops the captured messages, typically between two contract calls.
+func (l *DebugLog) Reset() {
+	l.lines = nil
+}
+
+func (l *DebugLog) append(msg string) {
+	l.lines = append(l.lines, msg)
+}
+
+// Logs returns the DebugLog of a std.Api returned by API, APIWithPrefix or Deps.
+// It panics if api was not created by this package.
+func Logs(a std.Api) *DebugLog {
+	mocked, ok := a.(api)
+	if !ok || mocked.log == nil {
+		panic("mock: std.Api not created by the mock package")
+	}
+	return mocked.log
+}
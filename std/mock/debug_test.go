@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogs(t *testing.T) {
+	deps := Deps(nil)
+
+	deps.Api.Debug("first message")
+	deps.Api.Debug("second message")
+	require.Equal(t, []string{"first message", "second message"}, Logs(deps.Api).Lines())
+
+	Logs(deps.Api).Reset()
+	require.Empty(t, Logs(deps.Api).Lines())
+
+	// every Api captures its own messages
+	other := API()
+	other.Debug("other")
+	require.Empty(t, Logs(deps.Api).Lines())
+	require.Equal(t, []string{"other"}, Logs(other).Lines())
+
+	require.Panics(t, func() { Logs(api{}) })
+}
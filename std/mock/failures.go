@@ -0,0 +1,162 @@
+package mock
+
+import (
+	"bytes"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// Call identifies a method of the mocked dependencies which can be made to fail.
+type Call string
+
+const (
+	CallCanonicalAddress         Call = "CanonicalAddress"
+	CallHumanAddress             Call = "HumanAddress"
+	CallValidateAddress          Call = "ValidateAddress"
+	CallVerifySecp256k1Signature Call = "VerifySecp256k1Signature"
+	CallRecoverSecp256k1PubKey   Call = "RecoverSecp256k1PubKey"
+	CallVerifyEd25519Signature   Call = "VerifyEd25519Signature"
+	CallVerifyEd25519Signatures  Call = "VerifyEd25519Signatures"
+	CallRawQuery                 Call = "RawQuery"
+	CallGet                      Call = "Get"
+	CallSet                      Call = "Set"
+	CallRemove                   Call = "Remove"
+	CallRange                    Call = "Range"
+)
+
+// Rule makes the calls to a mocked method fail.
+type Rule struct {
+	// Call is the method to fail.
+	Call Call
+	// Match selects the failing calls from their input, nil matches every call.
+	// The input is the address for the address methods, the hash or message for
+	// the crypto methods (nil for VerifyEd25519Signatures), the request for RawQuery,
+	// the key for Get, Set and Remove and the start key for Range.
+	Match func(input []byte) bool
+	// Err is returned by the failing calls.
+	Err error
+	// Panic, if not nil, is the value the failing calls panic with instead of returning Err.
+	// As std.Storage methods do not return errors, failing storage calls always panic,
+	// with Err if Panic is nil.
+	Panic interface{}
+	// Times is the number of calls failing before the rule is dropped, 0 means no limit.
+	Times int
+}
+
+// Failures holds the Rules injected into mocked dependencies.
+// The mocks created by Deps share the same Failures.
+type Failures struct {
+	rules []Rule
+}
+
+// Inject returns the Failures of a std.Api, std.Querier or std.Storage created by
+// this package, or of the mocks of a std.Deps created by Deps.
+// It panics for any other value.
+func Inject(target interface{}) *Failures {
+	var failures *Failures
+	switch t := target.(type) {
+	case api:
+		failures = t.failures
+	case *querier:
+		failures = t.failures
+	case *storage:
+		failures = t.failures
+	case *std.Deps:
+		return Inject(t.Api)
+	}
+	if failures == nil {
+		panic("mock: failures can only be injected in mocks created by the mock package")
+	}
+	return failures
+}
+
+// Add registers rule. The first registered rule matching a call applies.
+func (f *Failures) Add(rule Rule) {
+	if rule.Err == nil && rule.Panic == nil {
+		panic("mock: failure rule without error nor panic value")
+	}
+	f.rules = append(f.rules, rule)
+}
+
+// Reset drops all the rules.
+func (f *Failures) Reset() {
+	f.rules = nil
+}
+
+// FailCanonicalAddress makes Api.CanonicalAddress of human return err.
+func (f *Failures) FailCanonicalAddress(human string, err error) {
+	f.Add(Rule{Call: CallCanonicalAddress, Match: Equal([]byte(human)), Err: err})
+}
+
+// FailHumanAddress makes Api.HumanAddress of canonical return err.
+func (f *Failures) FailHumanAddress(canonical types.CanonicalAddress, err error) {
+	f.Add(Rule{Call: CallHumanAddress, Match: Equal(canonical), Err: err})
+}
+
+// FailValidateAddress makes Api.ValidateAddress of human return err.
+func (f *Failures) FailValidateAddress(human string, err error) {
+	f.Add(Rule{Call: CallValidateAddress, Match: Equal([]byte(human)), Err: err})
+}
+
+// FailNextQuery makes the next Querier.RawQuery return err,
+// like a types.SystemError reported by the chain.
+func (f *Failures) FailNextQuery(err error) {
+	f.Add(Rule{Call: CallRawQuery, Err: err, Times: 1})
+}
+
+// PanicOnGet makes Storage.Get of the keys starting with prefix panic with value.
+func (f *Failures) PanicOnGet(prefix []byte, value interface{}) {
+	f.Add(Rule{Call: CallGet, Match: HasPrefix(prefix), Panic: value})
+}
+
+// PanicOnSet makes Storage.Set of the keys starting with prefix panic with value.
+func (f *Failures) PanicOnSet(prefix []byte, value interface{}) {
+	f.Add(Rule{Call: CallSet, Match: HasPrefix(prefix), Panic: value})
+}
+
+// Equal returns a Rule.Match function matching the input equal to expected.
+func Equal(expected []byte) func(input []byte) bool {
+	return func(input []byte) bool {
+		return bytes.Equal(input, expected)
+	}
+}
+
+// HasPrefix returns a Rule.Match function matching the input starting with prefix.
+func HasPrefix(prefix []byte) func(input []byte) bool {
+	return func(input []byte) bool {
+		return bytes.HasPrefix(input, prefix)
+	}
+}
+
+// check applies the first rule matching the call, returning its error.
+func (f *Failures) check(call Call, input []byte) error {
+	if f == nil {
+		return nil
+	}
+	for i := range f.rules {
+		rule := f.rules[i]
+		if rule.Call != call || (rule.Match != nil && !rule.Match(input)) {
+			continue
+		}
+		if rule.Times > 0 {
+			f.rules[i].Times--
+			if f.rules[i].Times == 0 {
+				f.rules = append(f.rules[:i], f.rules[i+1:]...)
+			}
+		}
+		if rule.Panic != nil {
+			panic(rule.Panic)
+		}
+		return rule.Err
+	}
+	return nil
+}
+
+// mustCheck applies the first rule matching a call which can not return errors.
+func (f *Failures) mustCheck(call Call, input []byte) {
+	err := f.check(call, input)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+package mock
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func TestFailures_Api(t *testing.T) {
+	deps := Deps(nil)
+	failing, valid := AddrMake("failing"), AddrMake("valid")
+	errBoom := types.GenericError("boom")
+
+	Inject(deps.Api).FailCanonicalAddress(failing, errBoom)
+	_, err := deps.Api.CanonicalAddress(failing)
+	require.Equal(t, errBoom, err)
+	_, err = deps.Api.CanonicalAddress(valid)
+	require.NoError(t, err)
+
+	// rules only apply to the method they target
+	addr, err := deps.Api.ValidateAddress(failing)
+	require.NoError(t, err)
+	require.Equal(t, failing, addr.String())
+
+	Inject(deps.Api).FailValidateAddress(failing, errBoom)
+	_, err = deps.Api.ValidateAddress(failing)
+	require.Equal(t, errBoom, err)
+
+	canonical, err := deps.Api.CanonicalAddress(valid)
+	require.NoError(t, err)
+	Inject(deps.Api).FailHumanAddress(canonical, errBoom)
+	_, err = deps.Api.HumanAddress(canonical)
+	require.Equal(t, errBoom, err)
+
+	Inject(deps.Api).Add(Rule{Call: CallVerifyEd25519Signatures, Err: errBoom})
+	_, err = deps.Api.VerifyEd25519Signatures(nil, nil, nil)
+	require.Equal(t, errBoom, err)
+
+	Inject(deps.Api).Reset()
+	_, err = deps.Api.CanonicalAddress(failing)
+	require.NoError(t, err)
+}
+
+func TestFailures_Querier(t *testing.T) {
+	deps := Deps(nil)
+	querier := std.QuerierWrapper{Querier: deps.Querier}
+	errNoSuchContract := types.SystemError{NoSuchContract: &types.NoSuchContract{Addr: "contract"}}
+
+	Inject(deps.Querier).FailNextQuery(errNoSuchContract)
+	_, err := querier.QueryAllBalances(ContractAddress)
+	require.Equal(t, errNoSuchContract, err)
+
+	// only the next query fails
+	_, err = querier.QueryAllBalances(ContractAddress)
+	require.NoError(t, err)
+
+	// queries can be matched by their request
+	balanceRequest := `{"bank":{"balance":{"address":"alice","denom":"uatom"}}}`
+	Inject(deps.Querier).Add(Rule{
+		Call:  CallRawQuery,
+		Match: func(request []byte) bool { return string(request) == balanceRequest },
+		Err:   types.SystemError{Unknown: &types.Unknown{}},
+		Times: 2,
+	})
+	for i := 0; i < 2; i++ {
+		_, err = querier.QueryBalance("alice", "uatom")
+		require.Error(t, err)
+		_, err = querier.QueryBalance("bob", "uatom")
+		require.NoError(t, err)
+	}
+	_, err = querier.QueryBalance("alice", "uatom")
+	require.NoError(t, err)
+}
+
+func TestFailures_Storage(t *testing.T) {
+	deps := Deps(nil)
+	deps.Storage.Set([]byte("balance/alice"), []byte("10"))
+
+	// the dependencies share their failures
+	Inject(deps).PanicOnGet([]byte("balance/"), "out of gas")
+	require.PanicsWithValue(t, "out of gas", func() { deps.Storage.Get([]byte("balance/alice")) })
+	require.Nil(t, deps.Storage.Get([]byte("config")))
+
+	errDisk := errors.New("disk full")
+	Inject(deps.Storage).Add(Rule{Call: CallRemove, Err: errDisk})
+	require.PanicsWithError(t, errDisk.Error(), func() { deps.Storage.Remove([]byte("config")) })
+
+	Inject(deps.Storage).PanicOnSet(nil, "read only")
+	require.PanicsWithValue(t, "read only", func() { deps.Storage.Set([]byte("config"), []byte("{}")) })
+
+	require.Panics(t, func() { Inject(deps.Storage).Add(Rule{Call: CallGet}) })
+	require.Panics(t, func() { Inject(api{}) })
+}
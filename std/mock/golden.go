@@ -0,0 +1,206 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// StorageEntry is a key of a StorageDump. Values which are valid JSON are kept
+// as JSON, the others are hex encoded.
+type StorageEntry struct {
+	// Key is the key with the non printable bytes escaped as \xNN.
+	Key  string          `json:"key"`
+	JSON json.RawMessage `json:"json,omitempty"`
+	Hex  string          `json:"hex,omitempty"`
+}
+
+// StorageDump is a stable, human-readable form of all the keys of a storage, in ascending order.
+type StorageDump []StorageEntry
+
+// DumpStorage returns all the keys of storage.
+func DumpStorage(storage std.ReadonlyStorage) StorageDump {
+	dump := StorageDump{}
+	iter := storage.Range(nil, nil, std.Ascending)
+	defer std.CloseIterator(iter)
+	for {
+		key, value, err := iter.Next()
+		if err == std.ErrIteratorDone {
+			return dump
+		}
+		if err != nil {
+			panic(err)
+		}
+		entry := StorageEntry{Key: escapeKey(key)}
+		compacted := new(bytes.Buffer)
+		if len(value) != 0 && json.Compact(compacted, value) == nil {
+			entry.JSON = compacted.Bytes()
+		} else {
+			entry.Hex = hex.EncodeToString(value)
+		}
+		dump = append(dump, entry)
+	}
+}
+
+// JSON returns the indented JSON encoding of the dump.
+func (d StorageDump) JSON() []byte {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return append(data, '\n')
+}
+
+// DiffStorage returns the differences between two dumps, one line per key:
+// "+ key: value" for added keys, "- key: value" for removed keys and
+// "~ key: old -> new" for changed values. It is empty if the dumps are equal.
+func DiffStorage(before, after StorageDump) []string {
+	beforeValues := make(map[string]string, len(before))
+	for _, entry := range before {
+		beforeValues[entry.Key] = entry.value()
+	}
+	afterValues := make(map[string]string, len(after))
+	var keys []string
+	for _, entry := range after {
+		afterValues[entry.Key] = entry.value()
+		keys = append(keys, entry.Key)
+	}
+	for _, entry := range before {
+		if _, ok := afterValues[entry.Key]; !ok {
+			keys = append(keys, entry.Key)
+		}
+	}
+	sort.Strings(keys)
+
+	var diff []string
+	for _, key := range keys {
+		old, inBefore := beforeValues[key]
+		value, inAfter := afterValues[key]
+		switch {
+		case !inAfter:
+			diff = append(diff, "- "+key+": "+old)
+		case !inBefore:
+			diff = append(diff, "+ "+key+": "+value)
+		case old != value:
+			diff = append(diff, "~ "+key+": "+old+" -> "+value)
+		}
+	}
+	return diff
+}
+
+func (e StorageEntry) value() string {
+	if e.JSON != nil {
+		compacted := new(bytes.Buffer)
+		if json.Compact(compacted, e.JSON) == nil {
+			return compacted.String()
+		}
+		return string(e.JSON)
+	}
+	return "0x" + e.Hex
+}
+
+// CheckGoldenStorage compares all the keys of storage with the golden file
+// testdata/<name>.storage.json, returning an error listing the keys which differ.
+// If update is set, the golden file is rewritten first; tests usually set it
+// from a command line flag, eg. go test ./... -update-golden
+func CheckGoldenStorage(name string, storage std.ReadonlyStorage, update bool) error {
+	dump := DumpStorage(storage)
+	golden, err := readGolden(name+".storage.json", dump.JSON(), update)
+	if err != nil {
+		return err
+	}
+
+	expected := StorageDump{}
+	err = json.Unmarshal(golden, &expected)
+	if err != nil {
+		return errors.New("invalid golden file " + name + ": " + err.Error())
+	}
+	diff := DiffStorage(expected, dump)
+	if len(diff) != 0 {
+		msg := "storage differs from golden file " + name + ":"
+		for _, line := range diff {
+			msg += "\n" + line
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// CheckGoldenResponse compares resp with the golden file testdata/<name>.response.json.
+// If update is set, the golden file is rewritten first.
+func CheckGoldenResponse(name string, resp *types.Response, update bool) error {
+	data, err := resp.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	indented := new(bytes.Buffer)
+	err = json.Indent(indented, data, "", "  ")
+	if err != nil {
+		return err
+	}
+	indented.WriteByte('\n')
+
+	golden, err := readGolden(name+".response.json", indented.Bytes(), update)
+	if err != nil {
+		return err
+	}
+	var expected, actual interface{}
+	err = json.Unmarshal(golden, &expected)
+	if err != nil {
+		return errors.New("invalid golden file " + name + ": " + err.Error())
+	}
+	err = json.Unmarshal(data, &actual)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		return errors.New("response differs from golden file " + name + ":\n" + indented.String())
+	}
+	return nil
+}
+
+// readGolden returns the content of testdata/file, writing actual to it first
+// if update is set.
+func readGolden(file string, actual []byte, update bool) ([]byte, error) {
+	path := filepath.Join("testdata", file)
+	if update {
+		err := os.MkdirAll("testdata", 0o755)
+		if err != nil {
+			return nil, err
+		}
+		err = os.WriteFile(path, actual, 0o644)
+		if err != nil {
+			return nil, err
+		}
+	}
+	golden, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errors.New("missing golden file " + path + ", run the tests with update set to create it")
+	}
+	return golden, err
+}
+
+// escapeKey returns key as a string, escaping the bytes which are not printable ASCII.
+func escapeKey(key []byte) string {
+	escaped := make([]byte, 0, len(key))
+	for _, b := range key {
+		if b >= 0x20 && b < utf8.RuneSelf && b != '\\' {
+			escaped = append(escaped, b)
+			continue
+		}
+		escaped = append(escaped, '\\', 'x')
+		escaped = strconv.AppendUint(escaped, uint64(b>>4), 16)
+		escaped = strconv.AppendUint(escaped, uint64(b&0xf), 16)
+	}
+	return string(escaped)
+}
@@ -0,0 +1,81 @@
+package mock
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// updateGolden makes the golden checks rewrite the golden files instead
+// of comparing against them, eg. go test ./std/mock -update-golden
+var updateGolden = flag.Bool("update-golden", false, "regenerate the golden files under testdata")
+
+func TestDumpStorage(t *testing.T) {
+	store := Storage()
+	store.Set([]byte("\x00\x08balancesalice"), []byte(`{"amount": "10"}`))
+	store.Set([]byte("config"), []byte(`{"owner":"alice"}`))
+	store.Set([]byte("counter"), []byte{0x00, 0x00, 0x00, 0x2a})
+
+	dump := DumpStorage(store)
+	require.Equal(t, StorageDump{
+		{Key: `\x00\x08balancesalice`, JSON: []byte(`{"amount":"10"}`)},
+		{Key: "config", JSON: []byte(`{"owner":"alice"}`)},
+		{Key: "counter", Hex: "0000002a"},
+	}, dump)
+
+	require.NoError(t, CheckGoldenStorage("dump", store, *updateGolden))
+
+	// differences are listed by key
+	store.Set([]byte("config"), []byte(`{"owner":"bob"}`))
+	store.Remove([]byte("counter"))
+	err := CheckGoldenStorage("dump", store, false)
+	require.EqualError(t, err, "storage differs from golden file dump:\n"+
+		`~ config: {"owner":"alice"} -> {"owner":"bob"}`+"\n"+
+		`- counter: 0x0000002a`)
+}
+
+func TestDiffStorage(t *testing.T) {
+	before := Storage()
+	before.Set([]byte("a"), []byte(`1`))
+	before.Set([]byte("b"), []byte(`2`))
+	before.Set([]byte("\x01c"), []byte(`3`))
+
+	after := Storage()
+	after.Set([]byte("b"), []byte(`22`))
+	after.Set([]byte("\x01c"), []byte(`3`))
+	after.Set([]byte("d"), []byte{0xff})
+
+	require.Empty(t, DiffStorage(DumpStorage(before), DumpStorage(before)))
+	require.Equal(t, []string{
+		`- a: 1`,
+		`~ b: 2 -> 22`,
+		`+ d: 0xff`,
+	}, DiffStorage(DumpStorage(before), DumpStorage(after)))
+}
+
+func TestCheckGoldenResponse(t *testing.T) {
+	resp := &types.Response{
+		Messages: []types.SubMsg{{
+			ID: 1,
+			Msg: types.CosmosMsg{Bank: &types.BankMsg{Send: &types.SendMsg{
+				ToAddress: Addr("bob"),
+				Amount:    []types.Coin{types.NewCoinFromUint64(10, "uatom")},
+			}}},
+			ReplyOn: types.ReplySuccess,
+		}},
+		Data:       []byte("migrated"),
+		Attributes: []types.EventAttribute{{Key: "action", Value: "send"}},
+		Events: []types.Event{{
+			Type:       "transfer",
+			Attributes: []types.EventAttribute{{Key: "amount", Value: "10uatom"}},
+		}},
+	}
+	require.NoError(t, CheckGoldenResponse("send", resp, *updateGolden))
+
+	resp.Data = []byte("other")
+	require.Error(t, CheckGoldenResponse("send", resp, false))
+	require.Error(t, CheckGoldenResponse("missing", resp, false))
+}
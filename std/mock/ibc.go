@@ -0,0 +1,69 @@
+package mock
+
+import (
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// IBCQuerier answers the IBC queries of the mocked std.Querier.
+// PortID is the port of the contract, used by the queries without port.
+// An empty PortID makes the port queries fail, like for contracts
+// without IBC entry points.
+type IBCQuerier struct {
+	PortID   string
+	Channels []types.IBCChannel
+}
+
+// UpdateIBC replaces the IBC state of a std.Querier returned by Querier or Deps.
+func UpdateIBC(q std.Querier, portID string, channels []types.IBCChannel) {
+	mocked, ok := q.(*querier)
+	if !ok {
+		panic("mock: std.Querier not created by the mock package")
+	}
+	mocked.IBC = IBCQuerier{
+		PortID:   portID,
+		Channels: append([]types.IBCChannel(nil), channels...),
+	}
+}
+
+func (i IBCQuerier) HandleQuery(request *types.IBCQuery) (std.JSONType, error) {
+	switch {
+	case request.PortID != nil:
+		if i.PortID == "" {
+			return nil, errors.New("contract has no IBC port")
+		}
+		return &types.PortIDResponse{PortID: i.PortID}, nil
+	case request.ListChannels != nil:
+		port := i.port(request.ListChannels.PortID)
+		res := &types.ListChannelsResponse{}
+		for _, c := range i.Channels {
+			if c.Endpoint.PortID == port {
+				res.Channels = append(res.Channels, c)
+			}
+		}
+		return res, nil
+	case request.Channel != nil:
+		port := i.port(request.Channel.PortID)
+		res := &types.ChannelResponse{}
+		for j := range i.Channels {
+			c := i.Channels[j]
+			if c.Endpoint.PortID == port && c.Endpoint.ChannelID == request.Channel.ChannelID {
+				res.Channel = &c
+				break
+			}
+		}
+		return res, nil
+	default:
+		return nil, errors.New("unknown types.IBCQuery variant")
+	}
+}
+
+// port returns the queried port, defaulting to the port of the contract.
+func (i IBCQuerier) port(port string) string {
+	if port == "" {
+		return i.PortID
+	}
+	return port
+}
@@ -0,0 +1,59 @@
+package mock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func TestIBCQuerier(t *testing.T) {
+	channel := func(port, id, counterparty string) types.IBCChannel {
+		return types.IBCChannel{
+			Endpoint:             types.IBCEndpoint{PortID: port, ChannelID: id},
+			CounterpartyEndpoint: types.IBCEndpoint{PortID: "transfer", ChannelID: counterparty},
+			Order:                types.Unordered,
+			Version:              "ics20-1",
+			ConnectionID:         "connection-0",
+		}
+	}
+	ch0 := channel("wasm.contract", "channel-0", "channel-7")
+	ch1 := channel("wasm.contract", "channel-1", "channel-8")
+	other := channel("wasm.other", "channel-2", "channel-9")
+
+	deps := Deps(nil)
+	querier := std.QuerierWrapper{Querier: deps.Querier}
+
+	_, err := querier.QueryPortID()
+	require.Error(t, err)
+
+	UpdateIBC(deps.Querier, "wasm.contract", []types.IBCChannel{ch0, ch1, other})
+
+	port, err := querier.QueryPortID()
+	require.NoError(t, err)
+	require.Equal(t, "wasm.contract", port)
+
+	channels, err := querier.QueryListChannels("")
+	require.NoError(t, err)
+	require.Equal(t, []types.IBCChannel{ch0, ch1}, channels)
+	channels, err = querier.QueryListChannels("wasm.other")
+	require.NoError(t, err)
+	require.Equal(t, []types.IBCChannel{other}, channels)
+	channels, err = querier.QueryListChannels("wasm.none")
+	require.NoError(t, err)
+	require.Empty(t, channels)
+
+	got, err := querier.QueryChannel("", "channel-1")
+	require.NoError(t, err)
+	require.Equal(t, ch1, got)
+	got, err = querier.QueryChannel("wasm.other", "channel-2")
+	require.NoError(t, err)
+	require.Equal(t, other, got)
+
+	_, err = querier.QueryChannel("", "channel-2")
+	require.Equal(t, types.NotFound{Kind: "IBC channel channel-2"}, err)
+
+	require.Panics(t, func() { UpdateIBC(nil, "wasm.contract", nil) })
+}
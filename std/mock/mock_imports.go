@@ -2,6 +2,7 @@ package mock
 
 import (
 	"crypto/ed25519"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/internal/addr"
 	"github.com/CosmWasm/cosmwasm-go/std/types"
 
 	secp256k1 "github.com/btcsuite/btcd/btcec"
@@ -28,9 +30,8 @@ const (
 	ChainID = "cosmos-testnet-14002"
 )
 
-const (
-	canonicalAddressLength = 32
-)
+// Bech32Prefix is the default bech32 prefix of the addresses handled by the mocked std.Api.
+const Bech32Prefix = "cosmwasm"
 
 var (
 	_ std.Iterator        = (*iterator)(nil)
@@ -41,14 +42,22 @@ var (
 )
 
 // Deps returns mocked dependencies, funds can be provided optionally.
+// The dependencies share the Failures returned by Inject.
 func Deps(funds []types.Coin) *std.Deps {
+	failures := new(Failures)
 	return &std.Deps{
-		Storage: Storage(),
-		Api:     api{},
-		Querier: Querier(funds),
+		Storage: newStorage(failures),
+		Api:     newAPI(Bech32Prefix, failures),
+		Querier: newQuerier(funds, failures),
 	}
 }
 
+// Addr returns an address without validating it, for tests building the
+// types.Addr fields of messages and responses.
+func Addr(address string) types.Addr {
+	return addr.New(address)
+}
+
 // Env returns mocked environment.
 func Env() types.Env {
 	return types.Env{
@@ -58,7 +67,7 @@ func Env() types.Env {
 			ChainID: ChainID,
 		},
 		Contract: types.ContractInfo{
-			Address: ContractAddress,
+			Address: addr.New(ContractAddress),
 		},
 	}
 }
@@ -66,7 +75,7 @@ func Env() types.Env {
 // Info returns mocked message info, given a sender and the funds.
 func Info(sender string, funds []types.Coin) types.MessageInfo {
 	return types.MessageInfo{
-		Sender: sender,
+		Sender: addr.New(sender),
 		Funds:  funds,
 	}
 }
@@ -82,6 +91,8 @@ func newIterator(iter dbm.Iterator) iterator {
 	}
 }
 
+var _ std.ClosableIterator = iterator{}
+
 func (i iterator) Next() (key, value []byte, err error) {
 	if !i.Iter.Valid() {
 		i.Iter.Close()
@@ -92,17 +103,30 @@ func (i iterator) Next() (key, value []byte, err error) {
 	return
 }
 
+// Close implements std.ClosableIterator, releasing the tm-db iterator
+// when the iteration stops early.
+func (i iterator) Close() {
+	i.Iter.Close()
+}
+
 type storage struct {
-	storage dbm.DB
+	storage  dbm.DB
+	failures *Failures
 }
 
 func Storage() std.Storage {
+	return newStorage(new(Failures))
+}
+
+func newStorage(failures *Failures) *storage {
 	return &storage{
-		storage: dbm.NewMemDB(),
+		storage:  dbm.NewMemDB(),
+		failures: failures,
 	}
 }
 
 func (s *storage) Get(key []byte) []byte {
+	s.failures.mustCheck(CallGet, key)
 	v, err := s.storage.Get(key)
 	if err != nil {
 		// tm-db says that if the key is not found then the
@@ -114,6 +138,7 @@ func (s *storage) Get(key []byte) []byte {
 }
 
 func (s *storage) Range(start, end []byte, order std.Order) (iter std.Iterator) {
+	s.failures.mustCheck(CallRange, start)
 	var (
 		iterator dbm.Iterator
 		err      error
@@ -137,6 +162,7 @@ func (s *storage) Range(start, end []byte, order std.Order) (iter std.Iterator)
 }
 
 func (s *storage) Set(key, value []byte) {
+	s.failures.mustCheck(CallSet, key)
 	err := s.storage.Set(key, value)
 	if err != nil {
 		panic(err)
@@ -144,58 +170,136 @@ func (s *storage) Set(key, value []byte) {
 }
 
 func (s *storage) Remove(key []byte) {
+	s.failures.mustCheck(CallRemove, key)
 	err := s.storage.Delete(key)
 	if err != nil {
 		panic(err)
 	}
 }
 
-// API returns a mocked std.Api
+// API returns a mocked std.Api handling bech32 addresses with the Bech32Prefix prefix.
 func API() std.Api {
-	return api{}
+	return APIWithPrefix(Bech32Prefix)
+}
+
+// APIWithPrefix returns a mocked std.Api handling bech32 addresses with the provided prefix.
+func APIWithPrefix(prefix string) std.Api {
+	return newAPI(prefix, new(Failures))
+}
+
+func newAPI(prefix string, failures *Failures) api {
+	return api{prefix: prefix, log: new(DebugLog), failures: failures}
+}
+
+// AddrMake returns a deterministic valid address with the Bech32Prefix prefix
+// derived from input, like the addr_make function of the cosmwasm MockApi.
+func AddrMake(input string) string {
+	return AddrMakeWithPrefix(Bech32Prefix, input)
+}
+
+// AddrMakeWithPrefix returns a deterministic valid address with the provided
+// prefix derived from input: the bech32 encoding of the sha256 hash of input.
+func AddrMakeWithPrefix(prefix, input string) string {
+	hash := sha256.Sum256([]byte(input))
+	addr, err := Bech32Encode(prefix, hash[:])
+	if err != nil {
+		panic(err)
+	}
+	return addr
 }
 
-type api struct{}
+type api struct {
+	prefix   string
+	log      *DebugLog
+	failures *Failures
+}
 
+func (a api) bech32Prefix() string {
+	if a.prefix == "" {
+		return Bech32Prefix
+	}
+	return a.prefix
+}
+
+// CanonicalAddress decodes a bech32 address with a 20 or 32 bytes payload.
 func (a api) CanonicalAddress(human string) (types.CanonicalAddress, error) {
+	if err := a.failures.check(CallCanonicalAddress, []byte(human)); err != nil {
+		return nil, err
+	}
+	return a.canonicalAddress(human)
+}
+
+func (a api) canonicalAddress(human string) (types.CanonicalAddress, error) {
 	if len(human) == 0 {
 		return nil, errors.New("empty address")
 	}
-	if len(human) > canonicalAddressLength {
-		return nil, errors.New("human encoding too long")
+	prefix, canonical, err := Bech32Decode(human)
+	if err != nil {
+		return nil, errors.New("error decoding bech32: " + err.Error())
 	}
-
-	return []byte(human), nil
+	if prefix != a.bech32Prefix() {
+		return nil, errors.New("wrong bech32 prefix")
+	}
+	if !validCanonicalLength(canonical) {
+		return nil, errors.New("invalid canonical address length")
+	}
+	return canonical, nil
 }
 
-func (a api) HumanAddress(canonical types.CanonicalAddress) (string, error) {
-	if len(canonical) != canonicalAddressLength {
-		return "", errors.New("wrong canonical address length")
+// HumanAddress encodes a 20 or 32 bytes canonical address to bech32.
+func (a api) HumanAddress(canonical types.CanonicalAddress) (types.Addr, error) {
+	if err := a.failures.check(CallHumanAddress, canonical); err != nil {
+		return types.Addr{}, err
 	}
+	return a.humanAddress(canonical)
+}
 
-	cutIndex := canonicalAddressLength
-	for i, v := range canonical {
-		if v == 0 {
-			cutIndex = i
-			break
-		}
+func (a api) humanAddress(canonical types.CanonicalAddress) (types.Addr, error) {
+	if !validCanonicalLength(canonical) {
+		return types.Addr{}, errors.New("invalid canonical address length")
 	}
-
-	return string(canonical[:cutIndex]), nil
+	human, err := Bech32Encode(a.bech32Prefix(), canonical)
+	if err != nil {
+		return types.Addr{}, err
+	}
+	return addr.New(human), nil
 }
 
-func (a api) ValidateAddress(human string) error {
-	if len(human) > canonicalAddressLength {
-		return errors.New("human encoding too long")
+// ValidateAddress checks that human is a bech32 address in its normalized form.
+func (a api) ValidateAddress(human string) (types.Addr, error) {
+	if err := a.failures.check(CallValidateAddress, []byte(human)); err != nil {
+		return types.Addr{}, err
+	}
+	canonical, err := a.canonicalAddress(human)
+	if err != nil {
+		return types.Addr{}, err
 	}
-	return nil
+	normalized, err := a.humanAddress(canonical)
+	if err != nil {
+		return types.Addr{}, err
+	}
+	if normalized.String() != human {
+		return types.Addr{}, errors.New("address not normalized")
+	}
+	return normalized, nil
 }
 
+func validCanonicalLength(canonical []byte) bool {
+	return len(canonical) == 20 || len(canonical) == 32
+}
+
+// Debug prints msg and captures it in the DebugLog returned by Logs.
 func (a api) Debug(msg string) {
 	fmt.Println("DEBUG: " + msg)
+	if a.log != nil {
+		a.log.append(msg)
+	}
 }
 
 func (a api) VerifySecp256k1Signature(hash, signature, publicKey []byte) (bool, error) {
+	if err := a.failures.check(CallVerifySecp256k1Signature, hash); err != nil {
+		return false, err
+	}
 	if len(hash) != 32 {
 		return false, errors.New("invalid hash len (32 is expected)")
 	}
@@ -216,24 +320,47 @@ func (a api) VerifySecp256k1Signature(hash, signature, publicKey []byte) (bool,
 	return secpSig.Verify(hash, secpPubKey), nil
 }
 
+// RecoverSecp256k1PubKey recovers the uncompressed 65 bytes public key like the CosmWasm VM,
+// failing with the same errors returned by std.ExternalApi.
 func (a api) RecoverSecp256k1PubKey(hash, signature []byte, recoveryParam std.Secp256k1RecoveryParam) ([]byte, error) {
+	if err := a.failures.check(CallRecoverSecp256k1PubKey, hash); err != nil {
+		return nil, err
+	}
 	if len(hash) != 32 {
-		return nil, errors.New("invalid hash len (32 is expected)")
+		return nil, recoverError("invalid hash format")
 	}
 	if len(signature) != 64 {
-		return nil, errors.New("invalid signature len (64 is expected)")
+		return nil, recoverError("invalid signature format")
+	}
+	if recoveryParam > 1 {
+		return nil, recoverError("invalid recovery param")
+	}
+	// the VM rejects r and s outside of [1, n-1]
+	n := secp256k1.S256().N
+	r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+	if r.Sign() == 0 || s.Sign() == 0 || r.Cmp(n) >= 0 || s.Cmp(n) >= 0 {
+		return nil, recoverError("generic error")
 	}
-	// TODO: add proper implementation (github.com/btcsuite/btcd/btcec analog gives different results than the CosmWasm's one)
 
-	mockPubKey := make([]byte, 0, 65)
-	for i := 0; i < cap(mockPubKey); i++ {
-		mockPubKey = append(mockPubKey, byte(i))
+	// compact signatures are prefixed by 27 + the recovery id
+	compact := make([]byte, 0, 65)
+	compact = append(compact, 27+byte(recoveryParam))
+	compact = append(compact, signature...)
+	pubKey, _, err := secp256k1.RecoverCompact(secp256k1.S256(), compact, hash)
+	if err != nil {
+		return nil, recoverError("generic error")
 	}
+	return pubKey.SerializeUncompressed(), nil
+}
 
-	return mockPubKey, nil
+func recoverError(msg string) error {
+	return types.GenericError("secp256k1_recover_pubkey errored: " + msg)
 }
 
 func (a api) VerifyEd25519Signature(message, signature, publicKey []byte) (bool, error) {
+	if err := a.failures.check(CallVerifyEd25519Signature, message); err != nil {
+		return false, err
+	}
 	if len(signature) != ed25519.SignatureSize {
 		return false, errors.New("invalid signature len (64 is expected)")
 	}
@@ -244,17 +371,33 @@ func (a api) VerifyEd25519Signature(message, signature, publicKey []byte) (bool,
 	return ed25519Consensus.Verify(publicKey, message, signature), nil
 }
 
+// VerifyEd25519Signatures follows the batch rules of the VM: besides batches
+// of equal lengths, a single message may be signed by many keys and a single
+// key may sign many messages. An empty batch is valid.
 func (a api) VerifyEd25519Signatures(messages, signatures, publicKeys [][]byte) (bool, error) {
-	if len(messages) != len(signatures) || len(messages) != len(publicKeys) {
-		return false, errors.New("batch lengths mismatch")
+	if err := a.failures.check(CallVerifyEd25519Signatures, nil); err != nil {
+		return false, err
+	}
+	switch {
+	case len(messages) == len(signatures) && len(messages) == len(publicKeys):
+	case len(messages) == 1 && len(signatures) == len(publicKeys):
+		messages = repeat(messages[0], len(signatures))
+	case len(publicKeys) == 1 && len(messages) == len(signatures):
+		publicKeys = repeat(publicKeys[0], len(signatures))
+	default:
+		return false, batchError("batch error")
 	}
 
-	for i := 0; i < len(messages); i++ {
-		ok, err := a.VerifyEd25519Signature(messages[i], signatures[i], publicKeys[i])
-		if err != nil {
-			return false, err
+	for i := range signatures {
+		if len(signatures[i]) != ed25519.SignatureSize {
+			return false, batchError("invalid signature format")
+		}
+		if len(publicKeys[i]) != ed25519.PublicKeySize {
+			return false, batchError("invalid pubKey format")
 		}
-		if !ok {
+	}
+	for i := range signatures {
+		if !ed25519Consensus.Verify(publicKeys[i], messages[i], signatures[i]) {
 			return false, nil
 		}
 	}
@@ -262,13 +405,34 @@ func (a api) VerifyEd25519Signatures(messages, signatures, publicKeys [][]byte)
 	return true, nil
 }
 
+func repeat(item []byte, n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		items[i] = item
+	}
+	return items
+}
+
+func batchError(msg string) error {
+	return types.GenericError("ed25519_batch_verify errored: " + msg)
+}
+
 type querier struct {
 	Balances map[string][]types.Coin
+	Staking  StakingQuerier
+	IBC      IBCQuerier
+	Wasm     WasmQuerier
+	failures *Failures
 }
 
 func Querier(funds []types.Coin) std.Querier {
+	return newQuerier(funds, new(Failures))
+}
+
+func newQuerier(funds []types.Coin, failures *Failures) *querier {
 	q := querier{
 		Balances: make(map[string][]types.Coin),
+		failures: failures,
 	}
 	if len(funds) > 0 {
 		q.SetBalance(ContractAddress, funds)
@@ -277,6 +441,9 @@ func Querier(funds []types.Coin) std.Querier {
 }
 
 func (q *querier) RawQuery(raw []byte) ([]byte, error) {
+	if err := q.failures.check(CallRawQuery, raw); err != nil {
+		return nil, err
+	}
 	var request types.QueryRequest
 	err := request.UnmarshalJSON(raw)
 	if err != nil {
@@ -294,9 +461,11 @@ func (q *querier) HandleQuery(request types.QueryRequest) (std.JSONType, error)
 	case request.Bank != nil:
 		return q.HandleBank(request.Bank)
 	case request.Staking != nil:
-		return nil, errors.New("staking queries not implemented")
+		return q.Staking.HandleQuery(request.Staking)
+	case request.IBC != nil:
+		return q.IBC.HandleQuery(request.IBC)
 	case request.Wasm != nil:
-		return nil, errors.New("wasm queries not implemented")
+		return q.Wasm.HandleQuery(request.Wasm)
 	case request.Custom != nil:
 		return nil, errors.New("custom queries not implemented")
 	default:
@@ -1,9 +1,13 @@
 package mock
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/hex"
+	"strings"
 	"testing"
 
+	secp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -63,43 +67,114 @@ func assertKV(t *testing.T, iter std.Iterator, key, value []byte, isEnd bool) {
 }
 
 func TestMockApi_CanonicalAddress(t *testing.T) {
-	ea := api{}
-	humanAddr := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
-	longHumanAddr := humanAddr + "a"
-	expectedCanonAddr := types.CanonicalAddress(humanAddr)
+	ea := API()
+	// taken from the cosmwasm MockApi tests
+	humanAddr := "cosmwasm1h34lmpywh4upnjdg90cjf4j70aee6z8qqfspugamjp42e4q28kqs8s7vcp"
+	require.Equal(t, humanAddr, AddrMake("creator"))
 
-	canonAddr, err := ea.CanonicalAddress(longHumanAddr)
-	require.Error(t, err)
-	require.Nil(t, canonAddr)
+	canonAddr, err := ea.CanonicalAddress(humanAddr)
+	require.NoError(t, err)
+	expectedCanonAddr := sha256.Sum256([]byte("creator"))
+	require.Equal(t, types.CanonicalAddress(expectedCanonAddr[:]), canonAddr)
 
-	canonAddr, err = ea.CanonicalAddress(humanAddr)
+	// uppercase addresses can be decoded, but they are not normalized
+	canonAddr, err = ea.CanonicalAddress(strings.ToUpper(humanAddr))
+	require.NoError(t, err)
+	require.Equal(t, types.CanonicalAddress(expectedCanonAddr[:]), canonAddr)
+	_, err = ea.ValidateAddress(strings.ToUpper(humanAddr))
+	require.EqualError(t, err, "address not normalized")
+	addr, err := ea.ValidateAddress(humanAddr)
 	require.NoError(t, err)
-	require.Equal(t, expectedCanonAddr, canonAddr)
+	require.Equal(t, Addr(humanAddr), addr)
+
+	invalid := map[string]string{
+		"empty":          "",
+		"mixed case":     "cosmwasm1h34lmpywh4upnjdg90cjf4j70aee6z8qqfspugamjp42e4q28kqs8s7vcP",
+		"wrong checksum": "cosmwasm1h34lmpywh4upnjdg90cjf4j70aee6z8qqfspugamjp42e4q28kqs8s7vcq",
+		"wrong prefix":   AddrMakeWithPrefix("juno", "creator"),
+		"not bech32":     "creator",
+		"invalid length": mustBech32(t, Bech32Prefix, []byte{0xAA, 0xBB, 0xCC}),
+	}
+	for name, addr := range invalid {
+		canonAddr, err = ea.CanonicalAddress(addr)
+		require.Error(t, err, name)
+		require.Nil(t, canonAddr, name)
+		_, err = ea.ValidateAddress(addr)
+		require.Error(t, err, name)
+	}
 }
 
 func TestMockApi_HumanAddress(t *testing.T) {
-	ea := api{}
-	expectedHumanAddr := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
-	expectedCanonAddr := types.CanonicalAddress(expectedHumanAddr)
+	ea := API()
 
-	humanAddr, err := ea.HumanAddress(expectedCanonAddr)
-	require.NoError(t, err)
-	require.Equal(t, expectedHumanAddr, humanAddr)
+	// 20 and 32 bytes addresses round trip
+	for _, size := range []int{20, 32} {
+		canonAddr := make(types.CanonicalAddress, size)
+		for i := range canonAddr {
+			canonAddr[i] = byte(i)
+		}
+		humanAddr, err := ea.HumanAddress(canonAddr)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(humanAddr.String(), Bech32Prefix+"1"))
+		decoded, err := ea.CanonicalAddress(humanAddr.String())
+		require.NoError(t, err)
+		require.Equal(t, canonAddr, decoded)
+	}
 
 	// error report
-	longCanonAddr := make(types.CanonicalAddress, canonicalAddressLength)
-	copy(longCanonAddr, expectedCanonAddr)
-	longCanonAddr = append(longCanonAddr, 'a')
-	humanAddr, err = ea.HumanAddress(longCanonAddr)
-	require.Error(t, err)
-	require.Equal(t, "", humanAddr)
-
-	inputCanonAddr := make(types.CanonicalAddress, canonicalAddressLength)
-	copy(inputCanonAddr, expectedCanonAddr)
-	inputCanonAddr[9] = 0
-	humanAddr, err = ea.HumanAddress(inputCanonAddr)
+	for _, canonAddr := range []types.CanonicalAddress{nil, {0xAA, 0xBB, 0xCC}, make(types.CanonicalAddress, 33)} {
+		humanAddr, err := ea.HumanAddress(canonAddr)
+		require.Error(t, err)
+		require.Equal(t, types.Addr{}, humanAddr)
+	}
+
+	// the prefix is configurable
+	humanAddr, err := APIWithPrefix("juno").HumanAddress(make(types.CanonicalAddress, 20))
+	require.NoError(t, err)
+	require.Equal(t, "juno1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq93ryqp", humanAddr.String())
+	require.Equal(t, AddrMakeWithPrefix("juno", "creator")[:5], "juno1")
+}
+
+func TestBech32(t *testing.T) {
+	// valid strings from BIP-173
+	for _, valid := range []string{
+		"A12UEL5L",
+		"a12uel5l",
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+	} {
+		// the checksums are valid, but not all the vectors hold 8-bit aligned data
+		_, _, err := Bech32Decode(valid)
+		require.True(t, err == nil || err == errBech32Padding, valid)
+	}
+
+	// invalid strings from BIP-173
+	for _, invalid := range []string{
+		"\x201nwldj5",
+		"pzry9x0s0muk",
+		"1pzry9x0s0muk",
+		"x1b4n0q5v",
+		"li1dgmt3",
+		"de1lg7wt\xff",
+		"A1G7SGD8",
+		"10a06t8",
+		"1qzzfhee",
+	} {
+		_, _, err := Bech32Decode(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	hrp, data, err := Bech32Decode(mustBech32(t, "test", []byte("hello")))
+	require.NoError(t, err)
+	require.Equal(t, "test", hrp)
+	require.Equal(t, []byte("hello"), data)
+}
+
+func mustBech32(t *testing.T, hrp string, data []byte) string {
+	addr, err := Bech32Encode(hrp, data)
 	require.NoError(t, err)
-	require.Equal(t, "aaaaaaaaa", humanAddr)
+	return addr
 }
 
 func TestMockApi_VerifySecp256k1Signature(t *testing.T) {
@@ -172,6 +247,56 @@ func TestMockApi_VerifySecp256k1Signature(t *testing.T) {
 	}
 }
 
+func TestMockApi_RecoverSecp256k1PubKey(t *testing.T) {
+	mustDecode := func(hexStr string) []byte {
+		b, err := hex.DecodeString(hexStr)
+		require.NoError(t, err)
+		return b
+	}
+
+	// go-ethereum crypto test vector, the signature is followed by the recovery id
+	hash := mustDecode("ce0677bb30baa8cf067c88db9811f4333d131bf8bcf12fe7065d211dce971008")
+	sig := mustDecode("90f27b8b488db00b00606796d2987f6a5f59ae62ea05effe84fef5b8b0e549984a691139ad57a3f0b906637673aa2f63d1f55cb1a69199d4009eea23ceaddc93")
+	pubKey := mustDecode("04e32df42865e97135acfb65f3bae71bdc86f4d49150ad6a440b6f15878109880a0a2b2667f7e725ceea70c673093bf67663e0312623c8e091b13cf2c0f11ef652")
+
+	recovered, err := api{}.RecoverSecp256k1PubKey(hash, sig, 1)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, recovered)
+
+	// the other recovery param yields another key
+	recovered, err = api{}.RecoverSecp256k1PubKey(hash, sig, 0)
+	require.NoError(t, err)
+	require.Len(t, recovered, 65)
+	require.NotEqual(t, pubKey, recovered)
+
+	// signatures created with btcec round trip
+	privKey, err := secp256k1.NewPrivateKey(secp256k1.S256())
+	require.NoError(t, err)
+	compact, err := secp256k1.SignCompact(secp256k1.S256(), privKey, hash, false)
+	require.NoError(t, err)
+	recovered, err = api{}.RecoverSecp256k1PubKey(hash, compact[1:], std.Secp256k1RecoveryParam(compact[0]-27))
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey().SerializeUncompressed(), recovered)
+
+	// the errors match the ones returned by the VM
+	n := secp256k1.S256().N.Bytes()
+	errorCases := map[string]struct {
+		hash, sig     []byte
+		recoveryParam std.Secp256k1RecoveryParam
+		err           string
+	}{
+		"short hash":       {hash: hash[:31], sig: sig, err: "invalid hash format"},
+		"long signature":   {hash: hash, sig: append(sig, 0), err: "invalid signature format"},
+		"recovery param 2": {hash: hash, sig: sig, recoveryParam: 2, err: "invalid recovery param"},
+		"zero r":           {hash: hash, sig: append(make([]byte, 32), sig[32:]...), err: "generic error"},
+		"s equal to n":     {hash: hash, sig: append(append([]byte{}, sig[:32]...), n...), err: "generic error"},
+	}
+	for name, tc := range errorCases {
+		_, err = api{}.RecoverSecp256k1PubKey(tc.hash, tc.sig, tc.recoveryParam)
+		require.Equal(t, types.GenericError("secp256k1_recover_pubkey errored: "+tc.err), err, name)
+	}
+}
+
 func TestMockApi_VerifyEd25519Signature(t *testing.T) {
 	type testCase struct {
 		name            string
@@ -232,3 +357,96 @@ func TestMockApi_VerifyEd25519Signature(t *testing.T) {
 		})
 	}
 }
+
+func TestMockApi_VerifyEd25519Signatures(t *testing.T) {
+	_, key1, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, key2, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	pub1, pub2 := []byte(key1.Public().(ed25519.PublicKey)), []byte(key2.Public().(ed25519.PublicKey))
+	msg1, msg2 := []byte("hello"), []byte("world")
+
+	type testCase struct {
+		name       string
+		messages   [][]byte
+		signatures [][]byte
+		publicKeys [][]byte
+		//
+		errExpected string
+		resExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name:        "OK: empty batch",
+			resExpected: true,
+		},
+		{
+			name:        "OK: equal lengths",
+			messages:    [][]byte{msg1, msg2},
+			signatures:  [][]byte{ed25519.Sign(key1, msg1), ed25519.Sign(key2, msg2)},
+			publicKeys:  [][]byte{pub1, pub2},
+			resExpected: true,
+		},
+		{
+			name:        "OK: one message signed by many keys",
+			messages:    [][]byte{msg1},
+			signatures:  [][]byte{ed25519.Sign(key1, msg1), ed25519.Sign(key2, msg1)},
+			publicKeys:  [][]byte{pub1, pub2},
+			resExpected: true,
+		},
+		{
+			name:        "OK: many messages signed by one key",
+			messages:    [][]byte{msg1, msg2},
+			signatures:  [][]byte{ed25519.Sign(key1, msg1), ed25519.Sign(key1, msg2)},
+			publicKeys:  [][]byte{pub1},
+			resExpected: true,
+		},
+		{
+			name:       "OK: invalid signature",
+			messages:   [][]byte{msg1, msg2},
+			signatures: [][]byte{ed25519.Sign(key1, msg1), ed25519.Sign(key1, msg1)},
+			publicKeys: [][]byte{pub1},
+		},
+		{
+			name:        "Fail: mismatched lengths",
+			messages:    [][]byte{msg1, msg2},
+			signatures:  [][]byte{ed25519.Sign(key1, msg1)},
+			publicKeys:  [][]byte{pub1, pub2},
+			errExpected: "batch error",
+		},
+		{
+			name:        "Fail: no signatures for a message",
+			messages:    [][]byte{msg1},
+			publicKeys:  [][]byte{pub1},
+			errExpected: "batch error",
+		},
+		{
+			name:        "Fail: invalid signature len",
+			messages:    [][]byte{msg1},
+			signatures:  [][]byte{ed25519.Sign(key1, msg1)[:63]},
+			publicKeys:  [][]byte{pub1},
+			errExpected: "invalid signature format",
+		},
+		{
+			name:        "Fail: invalid pubKey len",
+			messages:    [][]byte{msg1},
+			signatures:  [][]byte{ed25519.Sign(key1, msg1)},
+			publicKeys:  [][]byte{pub1[:31]},
+			errExpected: "invalid pubKey format",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := api{}.VerifyEd25519Signatures(tc.messages, tc.signatures, tc.publicKeys)
+			if tc.errExpected != "" {
+				require.Equal(t, types.GenericError("ed25519_batch_verify errored: "+tc.errExpected), err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.resExpected, res)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package mock
+
+import (
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// StakingQuerier answers the staking queries of the mocked std.Querier,
+// like the StakingQuerier of the cosmwasm MockQuerier.
+type StakingQuerier struct {
+	BondedDenom string
+	Validators  []types.Validator
+	Delegations []types.FullDelegation
+}
+
+// UpdateStaking replaces the staking state of a std.Querier returned by Querier or Deps.
+func UpdateStaking(q std.Querier, bondedDenom string, validators []types.Validator, delegations []types.FullDelegation) {
+	mocked, ok := q.(*querier)
+	if !ok {
+		panic("mock: std.Querier not created by the mock package")
+	}
+	mocked.Staking = StakingQuerier{
+		BondedDenom: bondedDenom,
+		Validators:  append([]types.Validator(nil), validators...),
+		Delegations: append([]types.FullDelegation(nil), delegations...),
+	}
+}
+
+func (s StakingQuerier) HandleQuery(request *types.StakingQuery) (std.JSONType, error) {
+	switch {
+	case request.BondedDenom != nil:
+		return &types.BondedDenomResponse{Denom: s.BondedDenom}, nil
+	case request.AllValidators != nil:
+		return &types.AllValidatorsResponse{Validators: s.Validators}, nil
+	case request.Validator != nil:
+		res := &types.ValidatorResponse{}
+		for i := range s.Validators {
+			if s.Validators[i].Address == request.Validator.Address {
+				validator := s.Validators[i]
+				res.Validator = &validator
+				break
+			}
+		}
+		return res, nil
+	case request.AllDelegations != nil:
+		res := &types.AllDelegationsResponse{}
+		for _, d := range s.Delegations {
+			if d.Delegator == request.AllDelegations.Delegator {
+				res.Delegations = append(res.Delegations, types.Delegation{
+					Delegator: d.Delegator,
+					Validator: d.Validator,
+					Amount:    d.Amount,
+				})
+			}
+		}
+		return res, nil
+	case request.Delegation != nil:
+		res := &types.DelegationResponse{}
+		for i := range s.Delegations {
+			d := s.Delegations[i]
+			if d.Delegator == request.Delegation.Delegator && d.Validator == request.Delegation.Validator {
+				res.Delegation = &d
+				break
+			}
+		}
+		return res, nil
+	default:
+		return nil, errors.New("unknown types.StakingQuery variant")
+	}
+}
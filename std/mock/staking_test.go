@@ -0,0 +1,66 @@
+package mock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func TestStakingQuerier(t *testing.T) {
+	val1 := types.Validator{Address: "validator1", Commission: "0.05", MaxCommission: "0.1", MaxChangeRate: "0.01"}
+	val2 := types.Validator{Address: "validator2", Commission: "0.1", MaxCommission: "0.2", MaxChangeRate: "0.02"}
+	del1 := types.FullDelegation{
+		Delegator:          "alice",
+		Validator:          "validator1",
+		Amount:             types.NewCoinFromUint64(100, "ustake"),
+		AccumulatedRewards: []types.Coin{types.NewCoinFromUint64(5, "ustake")},
+		CanRedelegate:      types.NewCoinFromUint64(100, "ustake"),
+	}
+	del2 := types.FullDelegation{
+		Delegator:     "alice",
+		Validator:     "validator2",
+		Amount:        types.NewCoinFromUint64(50, "ustake"),
+		CanRedelegate: types.NewCoinFromUint64(0, "ustake"),
+	}
+
+	deps := Deps(nil)
+	UpdateStaking(deps.Querier, "ustake", []types.Validator{val1, val2}, []types.FullDelegation{del1, del2})
+	querier := std.QuerierWrapper{Querier: deps.Querier}
+
+	denom, err := querier.QueryBondedDenom()
+	require.NoError(t, err)
+	require.Equal(t, "ustake", denom)
+
+	validators, err := querier.QueryAllValidators()
+	require.NoError(t, err)
+	require.Equal(t, []types.Validator{val1, val2}, validators)
+
+	validator, err := querier.QueryValidator("validator2")
+	require.NoError(t, err)
+	require.Equal(t, &val2, validator)
+	validator, err = querier.QueryValidator("validator3")
+	require.NoError(t, err)
+	require.Nil(t, validator)
+
+	delegations, err := querier.QueryAllDelegations("alice")
+	require.NoError(t, err)
+	require.Equal(t, []types.Delegation{
+		{Delegator: "alice", Validator: "validator1", Amount: del1.Amount},
+		{Delegator: "alice", Validator: "validator2", Amount: del2.Amount},
+	}, delegations)
+	delegations, err = querier.QueryAllDelegations("bob")
+	require.NoError(t, err)
+	require.Empty(t, delegations)
+
+	delegation, err := querier.QueryDelegation("alice", "validator1")
+	require.NoError(t, err)
+	require.Equal(t, &del1, delegation)
+	delegation, err = querier.QueryDelegation("bob", "validator1")
+	require.NoError(t, err)
+	require.Nil(t, delegation)
+
+	require.Panics(t, func() { UpdateStaking(nil, "ustake", nil, nil) })
+}
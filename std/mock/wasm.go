@@ -0,0 +1,78 @@
+package mock
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// WasmQuerier answers the contract info, code info and raw queries
+// of the mocked std.Querier, smart queries are not supported.
+type WasmQuerier struct {
+	Contracts map[string]types.ContractInfoResponse
+	Codes     map[uint64]types.CodeInfoResponse
+	// Storages holds the storage of the contracts, read by the raw queries.
+	Storages map[string]std.ReadonlyStorage
+}
+
+// UpdateContract registers the contract at addr in a std.Querier returned by Querier or Deps.
+// The raw queries to the contract read storage, which may be nil.
+func UpdateContract(q std.Querier, addr string, info types.ContractInfoResponse, storage std.ReadonlyStorage) {
+	mocked, ok := q.(*querier)
+	if !ok {
+		panic("mock: std.Querier not created by the mock package")
+	}
+	if mocked.Wasm.Contracts == nil {
+		mocked.Wasm.Contracts = make(map[string]types.ContractInfoResponse)
+		mocked.Wasm.Storages = make(map[string]std.ReadonlyStorage)
+	}
+	mocked.Wasm.Contracts[addr] = info
+	mocked.Wasm.Storages[addr] = storage
+}
+
+// UpdateCode registers the code info in a std.Querier returned by Querier or Deps.
+func UpdateCode(q std.Querier, info types.CodeInfoResponse) {
+	mocked, ok := q.(*querier)
+	if !ok {
+		panic("mock: std.Querier not created by the mock package")
+	}
+	if mocked.Wasm.Codes == nil {
+		mocked.Wasm.Codes = make(map[uint64]types.CodeInfoResponse)
+	}
+	mocked.Wasm.Codes[info.CodeID] = info
+}
+
+func (w WasmQuerier) HandleQuery(request *types.WasmQuery) (std.JSONType, error) {
+	switch {
+	case request.Smart != nil:
+		return nil, errors.New("smart queries not implemented")
+	case request.Raw != nil:
+		addr := request.Raw.ContractAddr
+		if _, ok := w.Contracts[addr]; !ok {
+			return nil, types.NoSuchContract{Addr: addr}
+		}
+		// raw queries are answered with the value as is, empty if absent
+		value := types.RawMessage{}
+		if storage := w.Storages[addr]; storage != nil {
+			value = append(value, storage.Get(request.Raw.Key)...)
+		}
+		return &value, nil
+	case request.ContractInfo != nil:
+		addr := request.ContractInfo.ContractAddr
+		info, ok := w.Contracts[addr]
+		if !ok {
+			return nil, types.NoSuchContract{Addr: addr}
+		}
+		return &info, nil
+	case request.CodeInfo != nil:
+		info, ok := w.Codes[request.CodeInfo.CodeID]
+		if !ok {
+			return nil, types.GenericError("no such code: " + strconv.FormatUint(request.CodeInfo.CodeID, 10))
+		}
+		return &info, nil
+	default:
+		return nil, errors.New("unknown types.WasmQuery variant")
+	}
+}
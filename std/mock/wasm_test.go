@@ -0,0 +1,74 @@
+package mock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func TestWasmQuerier(t *testing.T) {
+	deps := Deps(nil)
+	querier := std.QuerierWrapper{Querier: deps.Querier}
+
+	contract := types.ContractInfoResponse{
+		CodeID:  7,
+		Creator: Addr("creator"),
+		Admin:   Addr("admin"),
+		Pinned:  true,
+		IBCPort: "wasm.contract",
+	}
+	store := Storage()
+	store.Set([]byte("config"), []byte(`{"owner":"admin"}`))
+	UpdateContract(deps.Querier, "contract", contract, store)
+	UpdateContract(deps.Querier, "other", types.ContractInfoResponse{CodeID: 8, Creator: Addr("creator")}, nil)
+
+	info, err := querier.QueryContractInfo("contract")
+	require.NoError(t, err)
+	require.Equal(t, &contract, info)
+	info, err = querier.QueryContractInfo("other")
+	require.NoError(t, err)
+	require.Equal(t, &types.ContractInfoResponse{CodeID: 8, Creator: Addr("creator")}, info)
+	_, err = querier.QueryContractInfo("unknown")
+	require.Equal(t, types.NoSuchContract{Addr: "unknown"}, err)
+
+	value, err := querier.QueryRaw("contract", []byte("config"))
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"owner":"admin"}`), value)
+	value, err = querier.QueryRaw("contract", []byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	value, err = querier.QueryRaw("other", []byte("config"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	_, err = querier.QueryRaw("unknown", []byte("config"))
+	require.Equal(t, types.NoSuchContract{Addr: "unknown"}, err)
+
+	code := types.CodeInfoResponse{
+		CodeID:   7,
+		Creator:  Addr("creator"),
+		Checksum: "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5",
+	}
+	UpdateCode(deps.Querier, code)
+	codeInfo, err := querier.QueryCodeInfo(7)
+	require.NoError(t, err)
+	require.Equal(t, &code, codeInfo)
+	_, err = querier.QueryCodeInfo(8)
+	require.Equal(t, types.GenericError("no such code: 8"), err)
+
+	require.Panics(t, func() { UpdateContract(nil, "contract", contract, nil) })
+	require.Panics(t, func() { UpdateCode(nil, code) })
+}
+
+func TestContractInfoResponse_JSON(t *testing.T) {
+	// wasmd leaves out the admin and port of the contracts without them
+	var info types.ContractInfoResponse
+	require.NoError(t, info.UnmarshalJSON([]byte(`{"code_id":1,"creator":"creator","admin":null,"pinned":false}`)))
+	require.Equal(t, types.ContractInfoResponse{CodeID: 1, Creator: Addr("creator")}, info)
+
+	data, err := info.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{"code_id":1,"creator":"creator","pinned":false}`, string(data))
+}
@@ -0,0 +1,116 @@
+package std
+
+const (
+	// DefaultPageLimit is the number of entries returned by a page when no limit is requested.
+	DefaultPageLimit uint32 = 10
+	// MaxPageLimit is the maximum number of entries returned by a page.
+	MaxPageLimit uint32 = 30
+)
+
+// Bound is one side of a paginated range.
+type Bound struct {
+	// Key is the key the range is bounded by.
+	Key []byte
+	// Exclusive reports whether Key itself is excluded from the range.
+	Exclusive bool
+}
+
+// InclusiveBound returns a Bound which includes key.
+func InclusiveBound(key []byte) *Bound {
+	return &Bound{Key: key}
+}
+
+// ExclusiveBound returns a Bound which excludes key.
+func ExclusiveBound(key []byte) *Bound {
+	return &Bound{Key: key, Exclusive: true}
+}
+
+// StartAfter returns the bounds of a range iterated with order which
+// continues after key, like the start_after field of list queries.
+// A nil key returns an unbounded range.
+func StartAfter(key []byte, order Order) (min, max *Bound) {
+	if key == nil {
+		return nil, nil
+	}
+	if order == Descending {
+		return nil, ExclusiveBound(key)
+	}
+	return ExclusiveBound(key), nil
+}
+
+// PageLimit returns the requested limit bounded by MaxPageLimit,
+// or DefaultPageLimit if limit is nil.
+func PageLimit(limit *uint32) uint32 {
+	return ClampLimit(limit, DefaultPageLimit, MaxPageLimit)
+}
+
+// ClampLimit returns the requested limit bounded by maxLimit,
+// or defaultLimit if limit is nil.
+func ClampLimit(limit *uint32, defaultLimit, maxLimit uint32) uint32 {
+	if limit == nil {
+		return defaultLimit
+	}
+	if *limit > maxLimit {
+		return maxLimit
+	}
+	return *limit
+}
+
+// Page is a page of entries read by Paginate.
+type Page struct {
+	// Keys are the keys of the entries, in the requested order.
+	Keys [][]byte
+	// Values are the values of the entries, Values[i] is stored at Keys[i].
+	Values [][]byte
+	// Next is the cursor of the following page, which is passed to StartAfter.
+	// It is nil if there are no more entries.
+	Next []byte
+}
+
+// Paginate reads at most limit entries of storage between min and max with
+// the provided order. A nil min or max leaves that side of the range unbounded.
+// Use PageLimit or ClampLimit to turn a requested limit into limit.
+func Paginate(storage ReadonlyStorage, min, max *Bound, order Order, limit uint32) (Page, error) {
+	var start, end []byte
+	if min != nil {
+		start = min.Key
+		if min.Exclusive {
+			start = successor(start)
+		}
+	}
+	if max != nil {
+		end = max.Key
+		if !max.Exclusive {
+			end = successor(end)
+		}
+	}
+
+	page := Page{}
+	if limit == 0 {
+		return page, nil
+	}
+	iter := storage.Range(start, end, order)
+	for {
+		key, value, err := iter.Next()
+		if err == ErrIteratorDone {
+			return page, nil
+		}
+		if err != nil {
+			return Page{}, err
+		}
+		// one more entry than requested is read to know whether a next page exists.
+		if uint32(len(page.Keys)) == limit {
+			page.Next = page.Keys[len(page.Keys)-1]
+			return page, nil
+		}
+		page.Keys = append(page.Keys, key)
+		page.Values = append(page.Values, value)
+	}
+}
+
+// successor returns the smallest key which is bigger than key.
+func successor(key []byte) []byte {
+	next := make([]byte, len(key)+1)
+	copy(next, key)
+	return next
+}
@@ -0,0 +1,95 @@
+package std_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+func pageKeys(page std.Page) []string {
+	keys := make([]string, len(page.Keys))
+	for i, key := range page.Keys {
+		keys[i] = string(key)
+	}
+	return keys
+}
+
+func TestPaginate(t *testing.T) {
+	store := mock.Storage()
+	for _, key := range []string{"a", "b", "b\x00", "c", "d", "e"} {
+		store.Set([]byte(key), []byte("value-"+key))
+	}
+
+	cases := map[string]struct {
+		min, max *std.Bound
+		order    std.Order
+		limit    uint32
+		keys     []string
+		next     string
+	}{
+		"unbounded":            {order: std.Ascending, limit: 10, keys: []string{"a", "b", "b\x00", "c", "d", "e"}},
+		"unbounded descending": {order: std.Descending, limit: 10, keys: []string{"e", "d", "c", "b\x00", "b", "a"}},
+		"limited":              {order: std.Ascending, limit: 2, keys: []string{"a", "b"}, next: "b"},
+		"exact limit":          {order: std.Ascending, limit: 6, keys: []string{"a", "b", "b\x00", "c", "d", "e"}},
+		"zero limit":           {order: std.Ascending, limit: 0, keys: []string{}},
+		"inclusive min":        {min: std.InclusiveBound([]byte("b")), order: std.Ascending, limit: 2, keys: []string{"b", "b\x00"}, next: "b\x00"},
+		"exclusive min":        {min: std.ExclusiveBound([]byte("b")), order: std.Ascending, limit: 2, keys: []string{"b\x00", "c"}, next: "c"},
+		"inclusive max":        {max: std.InclusiveBound([]byte("c")), order: std.Descending, limit: 2, keys: []string{"c", "b\x00"}, next: "b\x00"},
+		"exclusive max":        {max: std.ExclusiveBound([]byte("c")), order: std.Descending, limit: 2, keys: []string{"b\x00", "b"}, next: "b"},
+		"both bounds":          {min: std.ExclusiveBound([]byte("a")), max: std.InclusiveBound([]byte("d")), order: std.Ascending, limit: 10, keys: []string{"b", "b\x00", "c", "d"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			page, err := std.Paginate(store, tc.min, tc.max, tc.order, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.keys, pageKeys(page))
+			for i, key := range page.Keys {
+				require.Equal(t, "value-"+string(key), string(page.Values[i]))
+			}
+			if tc.next == "" {
+				require.Nil(t, page.Next)
+			} else {
+				require.Equal(t, tc.next, string(page.Next))
+			}
+		})
+	}
+}
+
+func TestPaginate_Cursor(t *testing.T) {
+	store := mock.Storage()
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		store.Set([]byte(key), []byte(key))
+	}
+
+	for _, order := range []std.Order{std.Ascending, std.Descending} {
+		var keys []string
+		var cursor []byte
+		for {
+			min, max := std.StartAfter(cursor, order)
+			page, err := std.Paginate(store, min, max, order, 2)
+			require.NoError(t, err)
+			keys = append(keys, pageKeys(page)...)
+			if page.Next == nil {
+				break
+			}
+			cursor = page.Next
+		}
+		if order == std.Ascending {
+			require.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+		} else {
+			require.Equal(t, []string{"e", "d", "c", "b", "a"}, keys)
+		}
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	limit := func(l uint32) *uint32 { return &l }
+	require.Equal(t, std.DefaultPageLimit, std.PageLimit(nil))
+	require.Equal(t, uint32(5), std.PageLimit(limit(5)))
+	require.Equal(t, std.MaxPageLimit, std.PageLimit(limit(1000)))
+	require.Equal(t, uint32(3), std.ClampLimit(nil, 3, 4))
+	require.Equal(t, uint32(4), std.ClampLimit(limit(5), 3, 4))
+}
@@ -0,0 +1,207 @@
+package proto
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+// DecodeErr is returned when decoding an invalid protobuf message.
+type DecodeErr struct {
+	Reason string
+}
+
+func (e DecodeErr) Error() string {
+	return "proto: " + e.Reason
+}
+
+// Decoder reads the fields of a protobuf message.
+// Next moves to the next field, whose value must then be read with the
+// method matching its type, or skipped with Skip. After an error, Next
+// returns false and the value methods return zero values.
+type Decoder struct {
+	Data []byte
+
+	pos   int
+	field int
+	wire  WireType
+	err   error
+}
+
+// Next moves to the next field, it returns false at the end of the message or on error.
+func (d *Decoder) Next() bool {
+	if d.err != nil || d.pos >= len(d.Data) {
+		return false
+	}
+	tag := d.varint()
+	if d.err != nil {
+		return false
+	}
+	d.field = int(tag >> 3)
+	d.wire = WireType(tag & 7)
+	if d.field == 0 || tag>>3 > 1<<29-1 {
+		d.fail("invalid field number " + strconv.FormatUint(tag>>3, 10))
+		return false
+	}
+	switch d.wire {
+	case WireVarint, WireFixed64, WireBytes, WireFixed32:
+		return true
+	default:
+		d.fail("unsupported wire type " + strconv.Itoa(int(d.wire)) + " for field " + strconv.Itoa(d.field))
+		return false
+	}
+}
+
+// Field returns the number of the current field.
+func (d *Decoder) Field() int {
+	return d.field
+}
+
+// WireType returns the wire type of the current field.
+func (d *Decoder) WireType() WireType {
+	return d.wire
+}
+
+// Error returns the first error met while decoding, if any.
+func (d *Decoder) Error() error {
+	return d.err
+}
+
+// Uint64 reads a uint64 field.
+func (d *Decoder) Uint64() uint64 {
+	if !d.expect(WireVarint) {
+		return 0
+	}
+	return d.varint()
+}
+
+// Int64 reads an int64 field.
+func (d *Decoder) Int64() int64 {
+	return int64(d.Uint64())
+}
+
+// Uint32 reads a uint32 field.
+func (d *Decoder) Uint32() uint32 {
+	return uint32(d.Uint64())
+}
+
+// Int32 reads an int32 field.
+func (d *Decoder) Int32() int32 {
+	return int32(d.Uint64())
+}
+
+// Bool reads a bool field.
+func (d *Decoder) Bool() bool {
+	return d.Uint64() != 0
+}
+
+// Fixed64 reads a fixed64 field.
+func (d *Decoder) Fixed64() uint64 {
+	if !d.expect(WireFixed64) {
+		return 0
+	}
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+// Fixed32 reads a fixed32 field.
+func (d *Decoder) Fixed32() uint32 {
+	if !d.expect(WireFixed32) {
+		return 0
+	}
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+// Bytes reads a bytes field. The returned slice shares the Data of the Decoder.
+func (d *Decoder) Bytes() []byte {
+	if !d.expect(WireBytes) {
+		return nil
+	}
+	n := d.varint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.Data)-d.pos) {
+		d.fail("unexpected end of field " + strconv.Itoa(d.field))
+		return nil
+	}
+	return d.next(int(n))
+}
+
+// String reads a string field.
+func (d *Decoder) String() string {
+	return string(d.Bytes())
+}
+
+// Message reads an embedded message field, which can be decoded with another Decoder.
+func (d *Decoder) Message() []byte {
+	return d.Bytes()
+}
+
+// Skip skips the value of the current field.
+func (d *Decoder) Skip() {
+	switch d.wire {
+	case WireVarint:
+		d.Uint64()
+	case WireFixed64:
+		d.Fixed64()
+	case WireBytes:
+		d.Bytes()
+	case WireFixed32:
+		d.Fixed32()
+	}
+}
+
+func (d *Decoder) expect(wire WireType) bool {
+	if d.err != nil {
+		return false
+	}
+	if d.wire != wire {
+		d.fail("unexpected wire type " + strconv.Itoa(int(d.wire)) + " for field " + strconv.Itoa(d.field))
+		return false
+	}
+	return true
+}
+
+func (d *Decoder) next(n int) []byte {
+	if len(d.Data)-d.pos < n {
+		d.fail("unexpected end of field " + strconv.Itoa(d.field))
+		return nil
+	}
+	b := d.Data[d.pos : d.pos+n]
+	d.pos += n
+	return b
+}
+
+func (d *Decoder) varint() uint64 {
+	var v uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		if d.pos >= len(d.Data) {
+			d.fail("unexpected end of varint")
+			return 0
+		}
+		b := d.Data[d.pos]
+		d.pos++
+		if shift == 63 && b > 1 {
+			break
+		}
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v
+		}
+	}
+	d.fail("varint overflows 64 bits")
+	return 0
+}
+
+func (d *Decoder) fail(reason string) {
+	if d.err == nil {
+		d.err = DecodeErr{Reason: reason}
+	}
+}
@@ -0,0 +1,121 @@
+// Package proto implements a small protobuf wire format encoder and decoder
+// which, unlike the generated protobuf code, uses no reflection and can be
+// compiled with TinyGo. Messages are encoded and decoded field by field:
+//
+//	e := proto.Encoder{}
+//	e.String(1, denom)
+//	request := e.BuildBytes()
+//
+//	d := proto.Decoder{Data: response}
+//	for d.Next() {
+//		switch d.Field() {
+//		case 1:
+//			denom = d.String()
+//		default:
+//			d.Skip()
+//		}
+//	}
+//	err := d.Error()
+package proto
+
+// WireType is the encoding of a field value on the wire.
+type WireType uint8
+
+const (
+	WireVarint  WireType = 0
+	WireFixed64 WireType = 1
+	WireBytes   WireType = 2
+	WireFixed32 WireType = 5
+)
+
+// Encoder builds a protobuf message. As in proto3, the methods writing
+// a single field leave out the default (zero) values.
+type Encoder struct {
+	buf []byte
+}
+
+// BuildBytes returns the encoded message.
+func (e *Encoder) BuildBytes() []byte {
+	return e.buf
+}
+
+// Uint64 writes a uint64 field.
+func (e *Encoder) Uint64(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.tag(field, WireVarint)
+	e.varint(v)
+}
+
+// Int64 writes an int64 field.
+func (e *Encoder) Int64(field int, v int64) {
+	e.Uint64(field, uint64(v))
+}
+
+// Uint32 writes a uint32 field.
+func (e *Encoder) Uint32(field int, v uint32) {
+	e.Uint64(field, uint64(v))
+}
+
+// Int32 writes an int32 field, negative values are sign extended to 64 bits.
+func (e *Encoder) Int32(field int, v int32) {
+	e.Uint64(field, uint64(int64(v)))
+}
+
+// Bool writes a bool field.
+func (e *Encoder) Bool(field int, v bool) {
+	if v {
+		e.Uint64(field, 1)
+	}
+}
+
+// String writes a string field.
+func (e *Encoder) String(field int, v string) {
+	if v == "" {
+		return
+	}
+	e.tag(field, WireBytes)
+	e.varint(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// Bytes writes a bytes field.
+func (e *Encoder) Bytes(field int, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	e.tag(field, WireBytes)
+	e.varint(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// Message writes an embedded message field from its encoding.
+// Unlike the scalar fields, an empty message is written, as it
+// is told apart from an unset one.
+func (e *Encoder) Message(field int, msg []byte) {
+	e.tag(field, WireBytes)
+	e.varint(uint64(len(msg)))
+	e.buf = append(e.buf, msg...)
+}
+
+// RepeatedString writes a repeated string field, including the empty elements.
+func (e *Encoder) RepeatedString(field int, values []string) {
+	for _, v := range values {
+		e.tag(field, WireBytes)
+		e.varint(uint64(len(v)))
+		e.buf = append(e.buf, v...)
+	}
+}
+
+func (e *Encoder) tag(field int, wire WireType) {
+	e.varint(uint64(field)<<3 | uint64(wire))
+}
+
+func (e *Encoder) varint(v uint64) {
+	for v >= 0x80 {
+		e.buf = append(e.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	e.buf = append(e.buf, byte(v))
+}
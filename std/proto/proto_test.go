@@ -0,0 +1,178 @@
+package proto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder(t *testing.T) {
+	// examples of https://developers.google.com/protocol-buffers/docs/encoding
+	e := Encoder{}
+	e.Uint64(1, 150)
+	require.Equal(t, "089601", hex.EncodeToString(e.BuildBytes()))
+
+	e = Encoder{}
+	e.String(2, "testing")
+	require.Equal(t, "120774657374696e67", hex.EncodeToString(e.BuildBytes()))
+
+	e = Encoder{}
+	e.Message(3, []byte{0x08, 0x96, 0x01})
+	require.Equal(t, "1a03089601", hex.EncodeToString(e.BuildBytes()))
+
+	// negative int32 are sign extended
+	e = Encoder{}
+	e.Int32(1, -1)
+	require.Equal(t, "08ffffffffffffffffff01", hex.EncodeToString(e.BuildBytes()))
+
+	// default values are left out, but for messages and repeated elements
+	e = Encoder{}
+	e.Uint64(1, 0)
+	e.Int32(2, 0)
+	e.Bool(3, false)
+	e.String(4, "")
+	e.Bytes(5, nil)
+	require.Empty(t, e.BuildBytes())
+	e.Message(6, nil)
+	e.RepeatedString(7, []string{"a", ""})
+	require.Equal(t, "32003a01613a00", hex.EncodeToString(e.BuildBytes()))
+
+	// large field numbers use several bytes
+	e = Encoder{}
+	e.Bool(16, true)
+	require.Equal(t, "800101", hex.EncodeToString(e.BuildBytes()))
+}
+
+func TestRoundTrip(t *testing.T) {
+	e := Encoder{}
+	e.Uint64(1, 1<<63+5)
+	e.Int64(2, -42)
+	e.Uint32(3, 1<<32-1)
+	e.Int32(4, -7)
+	e.Bool(5, true)
+	e.String(6, "denom")
+	e.Bytes(7, []byte{0, 1, 2})
+	inner := Encoder{}
+	inner.String(1, "inner")
+	e.Message(8, inner.BuildBytes())
+	e.RepeatedString(9, []string{"x", "y"})
+
+	var repeated []string
+	d := Decoder{Data: e.BuildBytes()}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			require.Equal(t, uint64(1<<63+5), d.Uint64())
+		case 2:
+			require.Equal(t, int64(-42), d.Int64())
+		case 3:
+			require.Equal(t, uint32(1<<32-1), d.Uint32())
+		case 4:
+			require.Equal(t, int32(-7), d.Int32())
+		case 5:
+			require.True(t, d.Bool())
+		case 6:
+			require.Equal(t, "denom", d.String())
+		case 7:
+			require.Equal(t, []byte{0, 1, 2}, d.Bytes())
+		case 8:
+			msg := Decoder{Data: d.Message()}
+			require.True(t, msg.Next())
+			require.Equal(t, 1, msg.Field())
+			require.Equal(t, "inner", msg.String())
+			require.False(t, msg.Next())
+			require.NoError(t, msg.Error())
+		case 9:
+			repeated = append(repeated, d.String())
+		default:
+			t.Fatalf("unexpected field %d", d.Field())
+		}
+	}
+	require.NoError(t, d.Error())
+	require.Equal(t, []string{"x", "y"}, repeated)
+}
+
+func TestDecoderSkip(t *testing.T) {
+	// varint, fixed64, bytes and fixed32 fields before field 15
+	data, err := hex.DecodeString("08960111010203040506070812026869" + "1d01020304" + "7801")
+	require.NoError(t, err)
+	d := Decoder{Data: data}
+	var skipped []WireType
+	for d.Next() {
+		if d.Field() == 15 {
+			require.True(t, d.Bool())
+			continue
+		}
+		skipped = append(skipped, d.WireType())
+		d.Skip()
+	}
+	require.NoError(t, d.Error())
+	require.Equal(t, []WireType{WireVarint, WireFixed64, WireBytes, WireFixed32}, skipped)
+
+	d = Decoder{Data: data}
+	require.True(t, d.Next())
+	d.Skip()
+	require.True(t, d.Next())
+	require.Equal(t, uint64(0x0807060504030201), d.Fixed64())
+	require.True(t, d.Next())
+	d.Skip()
+	require.True(t, d.Next())
+	require.Equal(t, uint32(0x04030201), d.Fixed32())
+}
+
+func TestDecoderErrors(t *testing.T) {
+	specs := map[string]struct {
+		data string
+		read func(d *Decoder)
+		err  string
+	}{
+		"truncated varint": {
+			data: "0896",
+			read: func(d *Decoder) { d.Uint64() },
+			err:  "proto: unexpected end of varint",
+		},
+		"varint overflow": {
+			data: "08ffffffffffffffffff02",
+			read: func(d *Decoder) { d.Uint64() },
+			err:  "proto: varint overflows 64 bits",
+		},
+		"truncated bytes": {
+			data: "120574657374",
+			read: func(d *Decoder) { d.Bytes() },
+			err:  "proto: unexpected end of field 2",
+		},
+		"truncated fixed64": {
+			data: "090102",
+			read: func(d *Decoder) { d.Fixed64() },
+			err:  "proto: unexpected end of field 1",
+		},
+		"wrong wire type": {
+			data: "0801",
+			read: func(d *Decoder) { d.Bytes() },
+			err:  "proto: unexpected wire type 0 for field 1",
+		},
+		"field zero": {
+			data: "0001",
+			err:  "proto: invalid field number 0",
+		},
+		"group": {
+			data: "0b",
+			err:  "proto: unsupported wire type 3 for field 1",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			data, err := hex.DecodeString(spec.data)
+			require.NoError(t, err)
+			d := Decoder{Data: data}
+			for d.Next() {
+				spec.read(&d)
+			}
+			require.EqualError(t, d.Error(), spec.err)
+			// the decoder stops at the first error
+			require.False(t, d.Next())
+			require.Zero(t, d.Uint64())
+		})
+	}
+}
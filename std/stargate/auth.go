@@ -0,0 +1,119 @@
+package stargate
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+)
+
+const AccountPath = "/cosmos.auth.v1beta1.Query/Account"
+
+// UnsupportedAccountErr is returned when querying an account of an unknown type.
+type UnsupportedAccountErr struct {
+	TypeURL string
+}
+
+func (e UnsupportedAccountErr) Error() string {
+	return "Unsupported account type: " + e.TypeURL
+}
+
+// Account is the base account of an auth module account.
+type Account struct {
+	// TypeURL is the type of the account, e.g. /cosmos.auth.v1beta1.BaseAccount.
+	TypeURL string
+	Address string
+	// PubKey is nil until the account signed a transaction.
+	PubKey        *Any
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// QueryAccount returns the account of address. Base, module and vesting
+// accounts are supported, other types return an UnsupportedAccountErr.
+// The query fails if the account does not exist.
+func QueryAccount(q std.QuerierWrapper, address string) (Account, error) {
+	e := proto.Encoder{}
+	e.String(1, address)
+	res, err := Query(q, AccountPath, e.BuildBytes())
+	if err != nil {
+		return Account{}, err
+	}
+	var msg Any
+	d := proto.Decoder{Data: res}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			msg, err = decodeAny(d.Message())
+			if err != nil {
+				return Account{}, err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	if d.Error() != nil {
+		return Account{}, d.Error()
+	}
+	return decodeAccount(msg)
+}
+
+func decodeAccount(msg Any) (Account, error) {
+	// depth of the BaseAccount, which is the first field of the other account types
+	var depth int
+	switch msg.TypeURL {
+	case "/cosmos.auth.v1beta1.BaseAccount":
+		depth = 0
+	case "/cosmos.auth.v1beta1.ModuleAccount",
+		"/cosmos.vesting.v1beta1.BaseVestingAccount":
+		depth = 1
+	case "/cosmos.vesting.v1beta1.ContinuousVestingAccount",
+		"/cosmos.vesting.v1beta1.DelayedVestingAccount",
+		"/cosmos.vesting.v1beta1.PeriodicVestingAccount",
+		"/cosmos.vesting.v1beta1.PermanentLockedAccount":
+		depth = 2
+	default:
+		return Account{}, UnsupportedAccountErr{TypeURL: msg.TypeURL}
+	}
+	base := msg.Value
+	for i := 0; i < depth; i++ {
+		var err error
+		base, err = firstMessage(base)
+		if err != nil {
+			return Account{}, err
+		}
+	}
+	account := Account{TypeURL: msg.TypeURL}
+	d := proto.Decoder{Data: base}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			account.Address = d.String()
+		case 2:
+			pubKey, err := decodeAny(d.Message())
+			if err != nil {
+				return Account{}, err
+			}
+			account.PubKey = &pubKey
+		case 3:
+			account.AccountNumber = d.Uint64()
+		case 4:
+			account.Sequence = d.Uint64()
+		default:
+			d.Skip()
+		}
+	}
+	return account, d.Error()
+}
+
+// firstMessage returns the embedded message field 1 of msg, or nil if it is unset.
+func firstMessage(msg []byte) ([]byte, error) {
+	var field []byte
+	d := proto.Decoder{Data: msg}
+	for d.Next() {
+		if d.Field() == 1 {
+			field = d.Message()
+		} else {
+			d.Skip()
+		}
+	}
+	return field, d.Error()
+}
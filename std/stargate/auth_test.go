@@ -0,0 +1,77 @@
+package stargate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+)
+
+func TestQueryAccount(t *testing.T) {
+	pubKey := proto.Encoder{}
+	pubKey.String(1, "/cosmos.crypto.secp256k1.PubKey")
+	pubKey.Bytes(2, []byte{0x0a, 0x21, 0x02})
+	base := proto.Encoder{}
+	base.String(1, "cosmos1abc")
+	base.Message(2, pubKey.BuildBytes())
+	base.Uint64(3, 7)
+	base.Uint64(4, 42)
+
+	module := proto.Encoder{}
+	module.Message(1, base.BuildBytes())
+	module.String(2, "distribution")
+
+	baseVesting := proto.Encoder{}
+	baseVesting.Message(1, base.BuildBytes())
+	baseVesting.Int64(5, 1700000000)
+	vesting := proto.Encoder{}
+	vesting.Message(1, baseVesting.BuildBytes())
+	vesting.Int64(2, 1600000000)
+
+	response := func(typeURL string, value []byte) []byte {
+		msg := proto.Encoder{}
+		msg.String(1, typeURL)
+		msg.Bytes(2, value)
+		res := proto.Encoder{}
+		res.Message(1, msg.BuildBytes())
+		return res.BuildBytes()
+	}
+	expected := func(typeURL string) Account {
+		return Account{
+			TypeURL:       typeURL,
+			Address:       "cosmos1abc",
+			PubKey:        &Any{TypeURL: "/cosmos.crypto.secp256k1.PubKey", Value: []byte{0x0a, 0x21, 0x02}},
+			AccountNumber: 7,
+			Sequence:      42,
+		}
+	}
+	const request = "0a0a636f736d6f7331616263"
+
+	specs := map[string][]byte{
+		"/cosmos.auth.v1beta1.BaseAccount":                 base.BuildBytes(),
+		"/cosmos.auth.v1beta1.ModuleAccount":               module.BuildBytes(),
+		"/cosmos.vesting.v1beta1.ContinuousVestingAccount": vesting.BuildBytes(),
+	}
+	for typeURL, value := range specs {
+		t.Run(typeURL, func(t *testing.T) {
+			account, err := QueryAccount(querier(AccountPath, request, response(typeURL, value)), "cosmos1abc")
+			require.NoError(t, err)
+			require.Equal(t, expected(typeURL), account)
+		})
+	}
+
+	// new accounts have no public key
+	fresh := proto.Encoder{}
+	fresh.String(1, "cosmos1abc")
+	fresh.Uint64(3, 8)
+	account, err := QueryAccount(querier(AccountPath, request, response("/cosmos.auth.v1beta1.BaseAccount", fresh.BuildBytes())), "cosmos1abc")
+	require.NoError(t, err)
+	require.Equal(t, Account{TypeURL: "/cosmos.auth.v1beta1.BaseAccount", Address: "cosmos1abc", AccountNumber: 8}, account)
+
+	_, err = QueryAccount(querier(AccountPath, request, response("/ethermint.types.v1.EthAccount", base.BuildBytes())), "cosmos1abc")
+	require.Equal(t, UnsupportedAccountErr{TypeURL: "/ethermint.types.v1.EthAccount"}, err)
+
+	_, err = QueryAccount(querier(AccountPath, request, response("/cosmos.auth.v1beta1.ModuleAccount", []byte{0x0a, 0x05})), "cosmos1abc")
+	require.IsType(t, proto.DecodeErr{}, err)
+}
@@ -0,0 +1,151 @@
+package stargate
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+const (
+	SupplyOfPath      = "/cosmos.bank.v1beta1.Query/SupplyOf"
+	DenomMetadataPath = "/cosmos.bank.v1beta1.Query/DenomMetadata"
+)
+
+// DenomUnit is a unit of a denom, 10^Exponent base units.
+type DenomUnit struct {
+	Denom    string
+	Exponent uint32
+	Aliases  []string
+}
+
+// DenomMetadata describes a denom, as registered in the bank module.
+type DenomMetadata struct {
+	Description string
+	DenomUnits  []DenomUnit
+	// Base is the denom of the smallest unit, used in the coins.
+	Base string
+	// Display is the denom of the unit displayed to users.
+	Display string
+	Name    string
+	Symbol  string
+	URI     string
+	URIHash string
+}
+
+// QuerySupply returns the total supply of denom.
+func QuerySupply(q std.QuerierWrapper, denom string) (types.Coin, error) {
+	e := proto.Encoder{}
+	e.String(1, denom)
+	res, err := Query(q, SupplyOfPath, e.BuildBytes())
+	if err != nil {
+		return types.Coin{}, err
+	}
+	coin := types.NewCoin(math.ZeroUint128(), denom)
+	d := proto.Decoder{Data: res}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			coin, err = decodeCoin(d.Message())
+			if err != nil {
+				return types.Coin{}, err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return coin, d.Error()
+}
+
+// QueryDenomMetadata returns the metadata of denom.
+// The query fails if no metadata was registered for denom.
+func QueryDenomMetadata(q std.QuerierWrapper, denom string) (DenomMetadata, error) {
+	e := proto.Encoder{}
+	e.String(1, denom)
+	res, err := Query(q, DenomMetadataPath, e.BuildBytes())
+	if err != nil {
+		return DenomMetadata{}, err
+	}
+	var metadata DenomMetadata
+	d := proto.Decoder{Data: res}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			metadata, err = decodeDenomMetadata(d.Message())
+			if err != nil {
+				return DenomMetadata{}, err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return metadata, d.Error()
+}
+
+func decodeCoin(data []byte) (types.Coin, error) {
+	coin := types.NewCoin(math.ZeroUint128(), "")
+	d := proto.Decoder{Data: data}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			coin.Denom = d.String()
+		case 2:
+			if err := coin.Amount.FromString(d.String()); err != nil {
+				return types.Coin{}, err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return coin, d.Error()
+}
+
+func decodeDenomMetadata(data []byte) (DenomMetadata, error) {
+	var metadata DenomMetadata
+	d := proto.Decoder{Data: data}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			metadata.Description = d.String()
+		case 2:
+			unit, err := decodeDenomUnit(d.Message())
+			if err != nil {
+				return DenomMetadata{}, err
+			}
+			metadata.DenomUnits = append(metadata.DenomUnits, unit)
+		case 3:
+			metadata.Base = d.String()
+		case 4:
+			metadata.Display = d.String()
+		case 5:
+			metadata.Name = d.String()
+		case 6:
+			metadata.Symbol = d.String()
+		case 7:
+			metadata.URI = d.String()
+		case 8:
+			metadata.URIHash = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return metadata, d.Error()
+}
+
+func decodeDenomUnit(data []byte) (DenomUnit, error) {
+	var unit DenomUnit
+	d := proto.Decoder{Data: data}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			unit.Denom = d.String()
+		case 2:
+			unit.Exponent = d.Uint32()
+		case 3:
+			unit.Aliases = append(unit.Aliases, d.String())
+		default:
+			d.Skip()
+		}
+	}
+	return unit, d.Error()
+}
@@ -0,0 +1,74 @@
+package stargate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func TestQuerySupply(t *testing.T) {
+	// QuerySupplyOfResponse{amount: {denom: "uatom", amount: "1000"}}
+	response := []byte("\x0a\x0d\x0a\x05uatom\x12\x041000")
+	q := querier(SupplyOfPath, "0a057561746f6d", response)
+	supply, err := QuerySupply(q, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, types.NewCoinFromUint64(1000, "uatom"), supply)
+
+	// the SDK returns a zero coin for unknown denoms
+	q = querier(SupplyOfPath, "0a057561746f6d", []byte("\x0a\x0a\x0a\x05uatom\x12\x010"))
+	supply, err = QuerySupply(q, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, types.NewCoinFromUint64(0, "uatom"), supply)
+
+	q = querier(SupplyOfPath, "0a057561746f6d", []byte("\x0a\x0b\x0a\x05uatom\x12\x02-1"))
+	_, err = QuerySupply(q, "uatom")
+	require.Error(t, err)
+}
+
+func TestQueryDenomMetadata(t *testing.T) {
+	unit := func(denom string, exponent uint32, aliases ...string) []byte {
+		e := proto.Encoder{}
+		e.String(1, denom)
+		e.Uint32(2, exponent)
+		e.RepeatedString(3, aliases)
+		return e.BuildBytes()
+	}
+	metadata := proto.Encoder{}
+	metadata.String(1, "The native staking token of the Cosmos Hub.")
+	metadata.Message(2, unit("uatom", 0, "microatom"))
+	metadata.Message(2, unit("atom", 6))
+	metadata.String(3, "uatom")
+	metadata.String(4, "atom")
+	metadata.String(5, "Cosmos Hub Atom")
+	metadata.String(6, "ATOM")
+	metadata.String(7, "https://cosmos.network")
+	metadata.String(8, "hash")
+	// unknown fields are skipped
+	metadata.Uint64(100, 1)
+	res := proto.Encoder{}
+	res.Message(1, metadata.BuildBytes())
+
+	q := querier(DenomMetadataPath, "0a057561746f6d", res.BuildBytes())
+	got, err := QueryDenomMetadata(q, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, DenomMetadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []DenomUnit{
+			{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+		URI:     "https://cosmos.network",
+		URIHash: "hash",
+	}, got)
+
+	q = querier(DenomMetadataPath, "0a057561746f6d", []byte("\x0a\x05\x12\x03\x0a"))
+	_, err = QueryDenomMetadata(q, "uatom")
+	require.IsType(t, proto.DecodeErr{}, err)
+}
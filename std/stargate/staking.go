@@ -0,0 +1,129 @@
+package stargate
+
+import (
+	"strings"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+)
+
+const StakingParamsPath = "/cosmos.staking.v1beta1.Query/Params"
+
+// decimalPlaces is the number of decimal places of the SDK Dec type.
+const decimalPlaces = 18
+
+// StakingParams are the parameters of the staking module.
+type StakingParams struct {
+	// UnbondingTime is the unbonding duration in nanoseconds.
+	UnbondingTime     uint64
+	MaxValidators     uint32
+	MaxEntries        uint32
+	HistoricalEntries uint32
+	BondDenom         string
+	// MinCommissionRate is the minimum commission rate of the validators as a decimal
+	// string (e.g. "0.05"), "0" on chains which do not set one.
+	MinCommissionRate string
+}
+
+// QueryStakingParams returns the parameters of the staking module.
+func QueryStakingParams(q std.QuerierWrapper) (StakingParams, error) {
+	res, err := Query(q, StakingParamsPath, nil)
+	if err != nil {
+		return StakingParams{}, err
+	}
+	params := StakingParams{MinCommissionRate: "0"}
+	d := proto.Decoder{Data: res}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			params, err = decodeStakingParams(d.Message())
+			if err != nil {
+				return StakingParams{}, err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return params, d.Error()
+}
+
+func decodeStakingParams(data []byte) (StakingParams, error) {
+	params := StakingParams{MinCommissionRate: "0"}
+	d := proto.Decoder{Data: data}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			duration, err := decodeDuration(d.Message())
+			if err != nil {
+				return StakingParams{}, err
+			}
+			params.UnbondingTime = duration
+		case 2:
+			params.MaxValidators = d.Uint32()
+		case 3:
+			params.MaxEntries = d.Uint32()
+		case 4:
+			params.HistoricalEntries = d.Uint32()
+		case 5:
+			params.BondDenom = d.String()
+		case 6:
+			rate, err := decodeDec(d.String())
+			if err != nil {
+				return StakingParams{}, err
+			}
+			params.MinCommissionRate = rate
+		default:
+			d.Skip()
+		}
+	}
+	return params, d.Error()
+}
+
+// decodeDuration returns a google.protobuf.Duration in nanoseconds.
+func decodeDuration(data []byte) (uint64, error) {
+	var seconds int64
+	var nanos int32
+	d := proto.Decoder{Data: data}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			seconds = d.Int64()
+		case 2:
+			nanos = d.Int32()
+		default:
+			d.Skip()
+		}
+	}
+	if d.Error() != nil {
+		return 0, d.Error()
+	}
+	if seconds < 0 || nanos < 0 || uint64(seconds) > (1<<64-1-uint64(nanos))/1e9 {
+		return 0, proto.DecodeErr{Reason: "duration out of range"}
+	}
+	return uint64(seconds)*1e9 + uint64(nanos), nil
+}
+
+// decodeDec returns the decimal string of an SDK Dec, which is encoded
+// as the integer string of its value multiplied by 10^18.
+func decodeDec(s string) (string, error) {
+	if s == "" {
+		return "0", nil
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return "", proto.DecodeErr{Reason: "invalid decimal " + s}
+		}
+	}
+	if len(s) <= decimalPlaces {
+		s = strings.Repeat("0", decimalPlaces+1-len(s)) + s
+	}
+	whole := strings.TrimLeft(s[:len(s)-decimalPlaces], "0")
+	if whole == "" {
+		whole = "0"
+	}
+	fraction := strings.TrimRight(s[len(s)-decimalPlaces:], "0")
+	if fraction == "" {
+		return whole, nil
+	}
+	return whole + "." + fraction, nil
+}
@@ -0,0 +1,72 @@
+package stargate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+)
+
+func TestQueryStakingParams(t *testing.T) {
+	duration := proto.Encoder{}
+	duration.Int64(1, 1814400)
+	duration.Int32(2, 5)
+	params := proto.Encoder{}
+	params.Message(1, duration.BuildBytes())
+	params.Uint32(2, 175)
+	params.Uint32(3, 7)
+	params.Uint32(4, 10000)
+	params.String(5, "uatom")
+	params.String(6, "50000000000000000")
+	res := proto.Encoder{}
+	res.Message(1, params.BuildBytes())
+
+	got, err := QueryStakingParams(querier(StakingParamsPath, "", res.BuildBytes()))
+	require.NoError(t, err)
+	require.Equal(t, StakingParams{
+		UnbondingTime:     1814400*1e9 + 5,
+		MaxValidators:     175,
+		MaxEntries:        7,
+		HistoricalEntries: 10000,
+		BondDenom:         "uatom",
+		MinCommissionRate: "0.05",
+	}, got)
+
+	// chains without a minimum commission rate
+	params = proto.Encoder{}
+	params.String(5, "stake")
+	res = proto.Encoder{}
+	res.Message(1, params.BuildBytes())
+	got, err = QueryStakingParams(querier(StakingParamsPath, "", res.BuildBytes()))
+	require.NoError(t, err)
+	require.Equal(t, StakingParams{BondDenom: "stake", MinCommissionRate: "0"}, got)
+
+	duration = proto.Encoder{}
+	duration.Int64(1, -1)
+	params = proto.Encoder{}
+	params.Message(1, duration.BuildBytes())
+	res = proto.Encoder{}
+	res.Message(1, params.BuildBytes())
+	_, err = QueryStakingParams(querier(StakingParamsPath, "", res.BuildBytes()))
+	require.Equal(t, proto.DecodeErr{Reason: "duration out of range"}, err)
+}
+
+func TestDecodeDec(t *testing.T) {
+	specs := map[string]string{
+		"":                       "0",
+		"0":                      "0",
+		"1":                      "0.000000000000000001",
+		"50000000000000000":      "0.05",
+		"1000000000000000000":    "1",
+		"12345000000000000000":   "12.345",
+		"0001500000000000000000": "1.5",
+	}
+	for dec, expected := range specs {
+		got, err := decodeDec(dec)
+		require.NoError(t, err)
+		require.Equal(t, expected, got, dec)
+	}
+	_, err := decodeDec("0.05")
+	require.Equal(t, proto.DecodeErr{Reason: "invalid decimal 0.05"}, err)
+}
@@ -0,0 +1,53 @@
+// Package stargate provides typed Stargate queries for a few Cosmos SDK gRPC services,
+// encoding the requests and decoding the responses with the std/proto codec.
+//
+// The chain must allow the queried paths, as wasmd only accepts the Stargate
+// queries of a configured list of services.
+//
+// The queries do not go through QuerierWrapper.Query and do not decode a
+// types.StargateResponse: the chain answers a Stargate query with the protobuf
+// response bytes and no JSON wrapper, so Query returns the result of RawQuery as is.
+package stargate
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/proto"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// Query sends the protobuf encoded request to the gRPC method path
+// (e.g. /cosmos.bank.v1beta1.Query/SupplyOf) and returns the protobuf encoded response.
+func Query(q std.QuerierWrapper, path string, request []byte) ([]byte, error) {
+	query := types.StargateQuery{
+		Path: path,
+		Data: request,
+	}
+	binQuery, err := query.ToQuery().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	// the chain returns the protobuf response as is, not a JSON response
+	return q.Querier.RawQuery(binQuery)
+}
+
+// Any is a google.protobuf.Any, a message with the URL of its type.
+type Any struct {
+	TypeURL string
+	Value   []byte
+}
+
+func decodeAny(data []byte) (Any, error) {
+	var a Any
+	d := proto.Decoder{Data: data}
+	for d.Next() {
+		switch d.Field() {
+		case 1:
+			a.TypeURL = d.String()
+		case 2:
+			a.Value = d.Bytes()
+		default:
+			d.Skip()
+		}
+	}
+	return a, d.Error()
+}
@@ -0,0 +1,58 @@
+package stargate
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// stargateQuerier answers the Stargate queries with the responses of the gRPC methods,
+// after checking the protobuf encoded request.
+type stargateQuerier map[string]struct {
+	request  string
+	response []byte
+}
+
+func (q stargateQuerier) RawQuery(request []byte) ([]byte, error) {
+	query := types.QueryRequest{}
+	err := query.UnmarshalJSON(request)
+	if err != nil {
+		return nil, err
+	}
+	if query.Stargate == nil {
+		return nil, errors.New("unexpected query")
+	}
+	method, ok := q[query.Stargate.Path]
+	if !ok {
+		return nil, types.UnsupportedRequest{Kind: "path " + query.Stargate.Path}
+	}
+	if hex.EncodeToString(query.Stargate.Data) != method.request {
+		return nil, errors.New("unexpected request " + hex.EncodeToString(query.Stargate.Data))
+	}
+	return method.response, nil
+}
+
+func querier(path, request string, response []byte) std.QuerierWrapper {
+	q := stargateQuerier{}
+	q[path] = struct {
+		request  string
+		response []byte
+	}{request, response}
+	return std.QuerierWrapper{Querier: q}
+}
+
+func TestQuery(t *testing.T) {
+	q := querier("/cosmos.bank.v1beta1.Query/Balance", "0a0361646412057561746f6d", []byte{1, 2, 3})
+
+	res, err := Query(q, "/cosmos.bank.v1beta1.Query/Balance", []byte("\x0a\x03add\x12\x05uatom"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, res)
+
+	_, err = Query(q, "/cosmos.bank.v1beta1.Query/AllBalances", nil)
+	require.Equal(t, types.UnsupportedRequest{Kind: "path /cosmos.bank.v1beta1.Query/AllBalances"}, err)
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+var _ std.Storage = (*CacheStorage)(nil)
+
+// pendingWrite is a write buffered by a CacheStorage.
+// A nil value means the key was removed.
+type pendingWrite struct {
+	key   []byte
+	value []byte
+}
+
+// CacheStorage is a std.Storage buffering Set and Remove in memory over
+// an underlying std.Storage, until they are either committed or discarded.
+// Reads see the pending writes.
+type CacheStorage struct {
+	parent  std.Storage
+	pending map[string]pendingWrite
+}
+
+// NewCacheStorage returns an empty CacheStorage over parent.
+func NewCacheStorage(parent std.Storage) *CacheStorage {
+	return &CacheStorage{
+		parent:  parent,
+		pending: make(map[string]pendingWrite),
+	}
+}
+
+// Get implements std.Storage.
+func (c *CacheStorage) Get(key []byte) []byte {
+	write, ok := c.pending[string(key)]
+	if ok {
+		return write.value
+	}
+	return c.parent.Get(key)
+}
+
+// Set implements std.Storage.
+func (c *CacheStorage) Set(key, value []byte) {
+	if value == nil {
+		value = []byte{}
+	}
+	c.pending[string(key)] = pendingWrite{key: cloneBytes(key), value: cloneBytes(value)}
+}
+
+// Remove implements std.Storage.
+func (c *CacheStorage) Remove(key []byte) {
+	c.pending[string(key)] = pendingWrite{key: cloneBytes(key)}
+}
+
+// Range implements std.Storage, merging the pending writes with the entries
+// of the parent storage in [start, end). Writes done after Range is called
+// are not seen by the returned iterator.
+func (c *CacheStorage) Range(start, end []byte, order std.Order) std.Iterator {
+	var writes []pendingWrite
+	for _, write := range c.pending {
+		if start != nil && bytes.Compare(write.key, start) < 0 {
+			continue
+		}
+		if end != nil && bytes.Compare(write.key, end) >= 0 {
+			continue
+		}
+		writes = append(writes, write)
+	}
+	sortWrites(writes, order)
+	return &cacheIterator{
+		parent:  c.parent.Range(start, end, order),
+		pending: writes,
+		order:   order,
+	}
+}
+
+// Commit writes the pending changes to the parent storage, in ascending key order.
+func (c *CacheStorage) Commit() {
+	writes := make([]pendingWrite, 0, len(c.pending))
+	for _, write := range c.pending {
+		writes = append(writes, write)
+	}
+	sortWrites(writes, std.Ascending)
+	for _, write := range writes {
+		if write.value == nil {
+			c.parent.Remove(write.key)
+			continue
+		}
+		c.parent.Set(write.key, write.value)
+	}
+	c.Discard()
+}
+
+// Discard drops the pending changes.
+func (c *CacheStorage) Discard() {
+	c.pending = make(map[string]pendingWrite)
+}
+
+func sortWrites(writes []pendingWrite, order std.Order) {
+	sort.Slice(writes, func(i, j int) bool {
+		if order == std.Descending {
+			return bytes.Compare(writes[i].key, writes[j].key) > 0
+		}
+		return bytes.Compare(writes[i].key, writes[j].key) < 0
+	})
+}
+
+func cloneBytes(b []byte) []byte {
+	clone := make([]byte, len(b))
+	copy(clone, b)
+	return clone
+}
+
+var _ std.ClosableIterator = (*cacheIterator)(nil)
+
+// cacheIterator merges the sorted pending writes with the parent iterator.
+type cacheIterator struct {
+	parent  std.Iterator
+	pending []pendingWrite
+	order   std.Order
+
+	// the next parent entry, which was read but not yielded yet.
+	parentKey   []byte
+	parentValue []byte
+	parentDone  bool
+}
+
+// Close implements std.ClosableIterator.
+func (i *cacheIterator) Close() {
+	std.CloseIterator(i.parent)
+}
+
+// Next implements std.Iterator.
+func (i *cacheIterator) Next() (key, value []byte, err error) {
+	for {
+		if i.parentKey == nil && !i.parentDone {
+			i.parentKey, i.parentValue, err = i.parent.Next()
+			if err == std.ErrIteratorDone {
+				i.parentDone = true
+			} else if err != nil {
+				return nil, nil, err
+			}
+		}
+		if len(i.pending) == 0 {
+			if i.parentDone {
+				return nil, nil, std.ErrIteratorDone
+			}
+			key, value = i.parentKey, i.parentValue
+			i.parentKey, i.parentValue = nil, nil
+			return key, value, nil
+		}
+
+		write := i.pending[0]
+		if !i.parentDone {
+			cmp := bytes.Compare(i.parentKey, write.key)
+			if i.order == std.Descending {
+				cmp = -cmp
+			}
+			if cmp < 0 {
+				key, value = i.parentKey, i.parentValue
+				i.parentKey, i.parentValue = nil, nil
+				return key, value, nil
+			}
+			if cmp == 0 {
+				// the pending write shadows the parent entry.
+				i.parentKey, i.parentValue = nil, nil
+			}
+		}
+		i.pending = i.pending[1:]
+		if write.value != nil {
+			return write.key, write.value, nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+func TestCacheStorage(t *testing.T) {
+	store := mock.Storage()
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("c"), []byte("3"))
+	store.Set([]byte("e"), []byte("5"))
+
+	cache := NewCacheStorage(store)
+	cache.Set([]byte("b"), []byte("2"))
+	cache.Set([]byte("c"), []byte("33"))
+	cache.Remove([]byte("e"))
+	cache.Set([]byte("f"), []byte("6"))
+	cache.Remove([]byte("g"))
+
+	// reads see the pending writes, the parent does not
+	require.Equal(t, []byte("33"), cache.Get([]byte("c")))
+	require.Nil(t, cache.Get([]byte("e")))
+	require.Equal(t, []byte("3"), store.Get([]byte("c")))
+	require.Nil(t, store.Get([]byte("b")))
+
+	require.Equal(t, []string{"a", "b", "c", "f"}, collectPKs(t, cache.Range(nil, nil, std.Ascending)))
+	require.Equal(t, []string{"f", "c", "b", "a"}, collectPKs(t, cache.Range(nil, nil, std.Descending)))
+	require.Equal(t, []string{"b", "c"}, collectPKs(t, cache.Range([]byte("b"), []byte("e"), std.Ascending)))
+	require.Equal(t, []string{"f", "c"}, collectPKs(t, cache.Range([]byte("c"), []byte("z"), std.Descending)))
+
+	iter := cache.Range([]byte("c"), nil, std.Ascending)
+	key, value, err := iter.Next()
+	require.NoError(t, err)
+	require.Equal(t, "c", string(key))
+	require.Equal(t, "33", string(value))
+
+	cache.Commit()
+	require.Equal(t, []string{"a", "b", "c", "f"}, collectPKs(t, store.Range(nil, nil, std.Ascending)))
+	require.Equal(t, []byte("33"), store.Get([]byte("c")))
+
+	// discarded writes never reach the parent
+	cache.Set([]byte("a"), []byte("11"))
+	cache.Remove([]byte("b"))
+	cache.Discard()
+	require.Equal(t, []byte("1"), cache.Get([]byte("a")))
+	require.Equal(t, []string{"a", "b", "c", "f"}, collectPKs(t, cache.Range(nil, nil, std.Ascending)))
+	require.Equal(t, []string{"a", "b", "c", "f"}, collectPKs(t, store.Range(nil, nil, std.Ascending)))
+}
+
+func TestCacheStorage_Nested(t *testing.T) {
+	store := mock.Storage()
+	outer := NewCacheStorage(store)
+	outer.Set([]byte("a"), []byte("1"))
+
+	inner := NewCacheStorage(outer)
+	inner.Set([]byte("b"), []byte("2"))
+	inner.Remove([]byte("a"))
+	require.Equal(t, []string{"b"}, collectPKs(t, inner.Range(nil, nil, std.Ascending)))
+
+	inner.Commit()
+	require.Equal(t, []string{"b"}, collectPKs(t, outer.Range(nil, nil, std.Ascending)))
+	require.Empty(t, collectPKs(t, store.Range(nil, nil, std.Ascending)))
+
+	outer.Commit()
+	require.Equal(t, []string{"b"}, collectPKs(t, store.Range(nil, nil, std.Ascending)))
+}
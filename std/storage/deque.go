@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/storage/keys"
+)
+
+var (
+	errDequeFull    = errors.New("storage: deque is full")
+	errDequeCorrupt = errors.New("storage: deque entry is missing")
+)
+
+var (
+	dequeHeadKey = []byte("h")
+	dequeTailKey = []byte("t")
+)
+
+// Deque is a double ended queue of values stored under a namespace.
+// Values are stored at fixed-width big-endian uint32 indexes between the
+// head and tail pointers, which matches the layout of the cw-storage-plus
+// Deque. Indexes wrap around, so a Deque can hold up to math.MaxUint32 values.
+type Deque struct {
+	entries Map
+}
+
+// NewDeque returns a Deque stored under the provided namespace.
+func NewDeque(namespace string) Deque {
+	return Deque{entries: NewMap(namespace)}
+}
+
+// Len returns the number of values in the Deque.
+func (d Deque) Len(storage std.ReadonlyStorage) uint32 {
+	return d.tail(storage) - d.head(storage)
+}
+
+// IsEmpty reports whether the Deque has no values.
+func (d Deque) IsEmpty(storage std.ReadonlyStorage) bool {
+	return d.Len(storage) == 0
+}
+
+// PushBack adds value at the end of the Deque.
+func (d Deque) PushBack(storage std.Storage, value std.JSONType) error {
+	if d.Len(storage) == math.MaxUint32 {
+		return errDequeFull
+	}
+	tail := d.tail(storage)
+	err := d.entries.Save(storage, keys.Uint32(tail), value)
+	if err != nil {
+		return err
+	}
+	d.setPointer(storage, dequeTailKey, tail+1)
+	return nil
+}
+
+// PushFront adds value at the start of the Deque.
+func (d Deque) PushFront(storage std.Storage, value std.JSONType) error {
+	if d.Len(storage) == math.MaxUint32 {
+		return errDequeFull
+	}
+	head := d.head(storage) - 1
+	err := d.entries.Save(storage, keys.Uint32(head), value)
+	if err != nil {
+		return err
+	}
+	d.setPointer(storage, dequeHeadKey, head)
+	return nil
+}
+
+// PopBack removes the last value of the Deque, decoding it into value.
+// It reports whether the Deque had a value to remove.
+func (d Deque) PopBack(storage std.Storage, value std.JSONType) (bool, error) {
+	if d.IsEmpty(storage) {
+		return false, nil
+	}
+	tail := d.tail(storage) - 1
+	err := d.load(storage, tail, value)
+	if err != nil {
+		return false, err
+	}
+	d.entries.Remove(storage, keys.Uint32(tail))
+	d.setPointer(storage, dequeTailKey, tail)
+	return true, nil
+}
+
+// PopFront removes the first value of the Deque, decoding it into value.
+// It reports whether the Deque had a value to remove.
+func (d Deque) PopFront(storage std.Storage, value std.JSONType) (bool, error) {
+	if d.IsEmpty(storage) {
+		return false, nil
+	}
+	head := d.head(storage)
+	err := d.load(storage, head, value)
+	if err != nil {
+		return false, err
+	}
+	d.entries.Remove(storage, keys.Uint32(head))
+	d.setPointer(storage, dequeHeadKey, head+1)
+	return true, nil
+}
+
+// Front decodes the first value of the Deque into value without removing it,
+// reporting whether the Deque has values.
+func (d Deque) Front(storage std.ReadonlyStorage, value std.JSONType) (bool, error) {
+	return d.Get(storage, 0, value)
+}
+
+// Back decodes the last value of the Deque into value without removing it,
+// reporting whether the Deque has values.
+func (d Deque) Back(storage std.ReadonlyStorage, value std.JSONType) (bool, error) {
+	length := d.Len(storage)
+	if length == 0 {
+		return false, nil
+	}
+	return d.Get(storage, length-1, value)
+}
+
+// Get decodes the value at position pos, counted from the front of the
+// Deque, into value. It reports whether pos is in the Deque.
+func (d Deque) Get(storage std.ReadonlyStorage, pos uint32, value std.JSONType) (bool, error) {
+	if pos >= d.Len(storage) {
+		return false, nil
+	}
+	err := d.load(storage, d.head(storage)+pos, value)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Range iterates over the values at the positions in [start, end) with the
+// given order, end is capped to the length of the Deque.
+func (d Deque) Range(storage std.ReadonlyStorage, start, end uint32, order std.Order) *DequeIterator {
+	length := d.Len(storage)
+	if end > length {
+		end = length
+	}
+	if start > end {
+		start = end
+	}
+	return &DequeIterator{
+		storage: storage,
+		entries: d.entries,
+		head:    d.head(storage),
+		start:   start,
+		end:     end,
+		order:   order,
+	}
+}
+
+// Iterate iterates over all the values of the Deque with the given order.
+func (d Deque) Iterate(storage std.ReadonlyStorage, order std.Order) *DequeIterator {
+	return d.Range(storage, 0, math.MaxUint32, order)
+}
+
+func (d Deque) load(storage std.ReadonlyStorage, index uint32, value std.JSONType) error {
+	found, err := d.entries.MayLoad(storage, keys.Uint32(index), value)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errDequeCorrupt
+	}
+	return nil
+}
+
+func (d Deque) head(storage std.ReadonlyStorage) uint32 {
+	return d.pointer(storage, dequeHeadKey)
+}
+
+func (d Deque) tail(storage std.ReadonlyStorage) uint32 {
+	return d.pointer(storage, dequeTailKey)
+}
+
+func (d Deque) pointer(storage std.ReadonlyStorage, key []byte) uint32 {
+	value := storage.Get(d.entries.Key(key))
+	if len(value) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(value)
+}
+
+func (d Deque) setPointer(storage std.Storage, key []byte, value uint32) {
+	storage.Set(d.entries.Key(key), keys.Uint32(value))
+}
+
+var _ std.Iterator = (*DequeIterator)(nil)
+
+// DequeIterator iterates over the values of a Deque.
+type DequeIterator struct {
+	storage    std.ReadonlyStorage
+	entries    Map
+	head       uint32
+	start, end uint32
+	order      std.Order
+}
+
+// Next implements std.Iterator, returning the position of the value as
+// a big-endian uint32 and the raw value.
+func (i *DequeIterator) Next() (key, value []byte, err error) {
+	if i.start >= i.end {
+		return nil, nil, std.ErrIteratorDone
+	}
+	var pos uint32
+	if i.order == std.Descending {
+		i.end--
+		pos = i.end
+	} else {
+		pos = i.start
+		i.start++
+	}
+	value = i.storage.Get(i.entries.Key(keys.Uint32(i.head + pos)))
+	if value == nil {
+		return nil, nil, errDequeCorrupt
+	}
+	return keys.Uint32(pos), value, nil
+}
+
+// NextValue advances the iterator, decoding the value into value
+// and returning its position.
+func (i *DequeIterator) NextValue(value std.JSONType) (pos uint32, err error) {
+	key, data, err := i.Next()
+	if err != nil {
+		return 0, err
+	}
+	err = value.UnmarshalJSON(data)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(key), nil
+}
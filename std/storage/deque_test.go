@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func dequeAmounts(t *testing.T, iter *DequeIterator) []uint64 {
+	var amounts []uint64
+	for {
+		coin := types.Coin{}
+		_, err := iter.NextValue(&coin)
+		if errors.Is(err, std.ErrIteratorDone) {
+			return amounts
+		}
+		require.NoError(t, err)
+		amounts = append(amounts, coin.Amount.Lo)
+	}
+}
+
+func TestDeque(t *testing.T) {
+	store := mock.Storage()
+	d := NewDeque("queue")
+
+	coin := types.Coin{}
+	require.True(t, d.IsEmpty(store))
+	found, err := d.PopFront(store, &coin)
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = d.Back(store, &coin)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, d.PushBack(store, newCoin(2)))
+	require.NoError(t, d.PushBack(store, newCoin(3)))
+	require.NoError(t, d.PushFront(store, newCoin(1)))
+	require.Equal(t, uint32(3), d.Len(store))
+
+	// the front wrapped around below index 0
+	require.Equal(t, []byte("\x00\x05queue\xff\xff\xff\xff"), d.entries.Key([]byte{0xff, 0xff, 0xff, 0xff}))
+	require.NotNil(t, store.Get(d.entries.Key([]byte{0xff, 0xff, 0xff, 0xff})))
+
+	found, err = d.Front(store, &coin)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newCoin(1), &coin)
+	found, err = d.Back(store, &coin)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newCoin(3), &coin)
+	found, err = d.Get(store, 1, &coin)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newCoin(2), &coin)
+	found, err = d.Get(store, 3, &coin)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.Equal(t, []uint64{1, 2, 3}, dequeAmounts(t, d.Iterate(store, std.Ascending)))
+	require.Equal(t, []uint64{3, 2, 1}, dequeAmounts(t, d.Iterate(store, std.Descending)))
+	require.Equal(t, []uint64{2, 3}, dequeAmounts(t, d.Range(store, 1, 10, std.Ascending)))
+	require.Empty(t, dequeAmounts(t, d.Range(store, 5, 10, std.Ascending)))
+
+	found, err = d.PopBack(store, &coin)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newCoin(3), &coin)
+	found, err = d.PopFront(store, &coin)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newCoin(1), &coin)
+	require.Equal(t, uint32(1), d.Len(store))
+	require.Equal(t, []uint64{2}, dequeAmounts(t, d.Iterate(store, std.Ascending)))
+}
+
+func TestDeque_MoreThanAByte(t *testing.T) {
+	store := mock.Storage()
+	d := NewDeque("queue")
+
+	for i := uint64(0); i < 300; i++ {
+		require.NoError(t, d.PushBack(store, newCoin(i)))
+	}
+	require.Equal(t, uint32(300), d.Len(store))
+
+	coin := types.Coin{}
+	for i := uint64(0); i < 300; i++ {
+		found, err := d.PopFront(store, &coin)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, newCoin(i), &coin)
+	}
+	require.True(t, d.IsEmpty(store))
+}
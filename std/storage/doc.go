@@ -0,0 +1,2 @@
+// Package storage contains typed collections built on top of std.Storage.
+package storage
@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/storage/keys"
+)
+
+var errCorruptedIndex = errors.New("storage: index entry without primary value")
+
+var (
+	_ Index = UniqueIndex{}
+	_ Index = MultiIndex{}
+)
+
+// Index is a secondary index kept up to date by an IndexedMap.
+type Index interface {
+	// Check returns the error Save would return for the value stored at the
+	// primary key pk, without writing anything.
+	Check(storage std.ReadonlyStorage, pk []byte, value std.JSONType) error
+	// Save adds the index entry of the value stored at the primary key pk.
+	Save(storage std.Storage, pk []byte, value std.JSONType) error
+	// Remove deletes the index entry of the old value stored at the primary key pk.
+	Remove(storage std.Storage, pk []byte, old std.JSONType)
+}
+
+// IndexFunc returns the index key of a value.
+type IndexFunc func(value std.JSONType) []byte
+
+// UniqueIndexErr is returned when saving a value whose index key
+// is already used by another primary key in a UniqueIndex.
+type UniqueIndexErr struct {
+	Index string
+}
+
+func (e UniqueIndexErr) Error() string {
+	return "Violates unique constraint on index " + e.Index
+}
+
+// UniqueIndex maps each index key to exactly one primary key.
+// Index keys are stored as they are, so ranges follow their byte order.
+type UniqueIndex struct {
+	name    string
+	idx     Map
+	primary Map
+	indexFn IndexFunc
+}
+
+// NewUniqueIndex returns a UniqueIndex stored under namespace, indexing the
+// values of the IndexedMap stored under pkNamespace.
+func NewUniqueIndex(namespace, pkNamespace string, indexFn IndexFunc) UniqueIndex {
+	return UniqueIndex{
+		name:    namespace,
+		idx:     NewMap(namespace),
+		primary: NewMap(pkNamespace),
+		indexFn: indexFn,
+	}
+}
+
+// Check implements Index.Check, returning UniqueIndexErr if the index key
+// belongs to another primary key.
+func (i UniqueIndex) Check(storage std.ReadonlyStorage, pk []byte, value std.JSONType) error {
+	existing := storage.Get(i.idx.Key(i.indexFn(value)))
+	if existing != nil && !bytes.Equal(existing, pk) {
+		return UniqueIndexErr{Index: i.name}
+	}
+	return nil
+}
+
+// Save implements Index.Save, returning UniqueIndexErr if the index key
+// belongs to another primary key.
+func (i UniqueIndex) Save(storage std.Storage, pk []byte, value std.JSONType) error {
+	err := i.Check(storage, pk, value)
+	if err != nil {
+		return err
+	}
+	storage.Set(i.idx.Key(i.indexFn(value)), pk)
+	return nil
+}
+
+// Remove implements Index.Remove.
+func (i UniqueIndex) Remove(storage std.Storage, _ []byte, old std.JSONType) {
+	storage.Remove(i.idx.Key(i.indexFn(old)))
+}
+
+// Load decodes the value indexed by ik into value and returns its primary key.
+// pk is nil if no value is indexed by ik.
+func (i UniqueIndex) Load(storage std.ReadonlyStorage, ik []byte, value std.JSONType) (pk []byte, err error) {
+	pk = storage.Get(i.idx.Key(ik))
+	if pk == nil {
+		return nil, nil
+	}
+	_, err = i.primary.MayLoad(storage, pk, value)
+	if err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
+// Range iterates over the values whose index key is in [start, end).
+func (i UniqueIndex) Range(storage std.ReadonlyStorage, start, end []byte, order std.Order) *IndexIterator {
+	return newIndexIterator(storage, i.idx.Range(storage, start, end, order), i.primary, uniquePK)
+}
+
+// Prefix iterates over the values whose composite index key starts with the
+// component prefix and whose remaining components are in [start, end).
+func (i UniqueIndex) Prefix(storage std.ReadonlyStorage, prefix, start, end []byte, order std.Order) *IndexIterator {
+	return newIndexIterator(storage, i.idx.Prefix(prefix).Range(storage, start, end, order), i.primary, uniquePK)
+}
+
+// MultiIndex maps each index key to any number of primary keys.
+// Entries are stored with the same layout as the cw-storage-plus MultiIndex:
+// the index key and the primary key joined by keys.Tuple, with the JSON encoded
+// primary key length as value.
+type MultiIndex struct {
+	idx     Map
+	primary Map
+	indexFn IndexFunc
+}
+
+// NewMultiIndex returns a MultiIndex stored under namespace, indexing the
+// values of the IndexedMap stored under pkNamespace.
+func NewMultiIndex(namespace, pkNamespace string, indexFn IndexFunc) MultiIndex {
+	return MultiIndex{
+		idx:     NewMap(namespace),
+		primary: NewMap(pkNamespace),
+		indexFn: indexFn,
+	}
+}
+
+// Check implements Index.Check, a MultiIndex accepts any value.
+func (i MultiIndex) Check(std.ReadonlyStorage, []byte, std.JSONType) error {
+	return nil
+}
+
+// Save implements Index.Save.
+func (i MultiIndex) Save(storage std.Storage, pk []byte, value std.JSONType) error {
+	storage.Set(i.idx.Key(keys.Tuple(i.indexFn(value), pk)), strconv.AppendUint(nil, uint64(len(pk)), 10))
+	return nil
+}
+
+// Remove implements Index.Remove.
+func (i MultiIndex) Remove(storage std.Storage, pk []byte, old std.JSONType) {
+	storage.Remove(i.idx.Key(keys.Tuple(i.indexFn(old), pk)))
+}
+
+// Prefix iterates over the values indexed by ik whose primary key is in [start, end).
+func (i MultiIndex) Prefix(storage std.ReadonlyStorage, ik, start, end []byte, order std.Order) *IndexIterator {
+	return newIndexIterator(storage, i.idx.Prefix(ik).Range(storage, start, end, order), i.primary, multiPK)
+}
+
+// Range iterates over the values whose index key is in [start, end).
+// As index keys are length-prefixed, the order of keys with different lengths
+// follows their length first.
+func (i MultiIndex) Range(storage std.ReadonlyStorage, start, end []byte, order std.Order) *IndexIterator {
+	if start != nil {
+		start = keys.LengthPrefixed(start)
+	}
+	if end != nil {
+		end = keys.LengthPrefixed(end)
+	}
+	return newIndexIterator(storage, i.idx.Range(storage, start, end, order), i.primary, multiPK)
+}
+
+func uniquePK(_, value []byte) []byte {
+	return value
+}
+
+func multiPK(key, value []byte) []byte {
+	size, err := strconv.ParseUint(string(value), 10, 32)
+	if err != nil || int(size) > len(key) {
+		return nil
+	}
+	return key[len(key)-int(size):]
+}
+
+var _ std.ClosableIterator = (*IndexIterator)(nil)
+
+// IndexIterator iterates over the entries of an index,
+// yielding the primary keys and the values they index.
+type IndexIterator struct {
+	storage std.ReadonlyStorage
+	iter    std.Iterator
+	primary Map
+	pkFn    func(key, value []byte) []byte
+}
+
+func newIndexIterator(storage std.ReadonlyStorage, iter std.Iterator, primary Map, pkFn func(key, value []byte) []byte) *IndexIterator {
+	return &IndexIterator{
+		storage: storage,
+		iter:    iter,
+		primary: primary,
+		pkFn:    pkFn,
+	}
+}
+
+// Next implements std.Iterator, returning the primary key
+// and the raw value it is indexing.
+func (i *IndexIterator) Next() (pk, value []byte, err error) {
+	key, entry, err := i.iter.Next()
+	if err != nil {
+		return nil, nil, err
+	}
+	pk = i.pkFn(key, entry)
+	value = i.storage.Get(i.primary.Key(pk))
+	if value == nil {
+		return nil, nil, errCorruptedIndex
+	}
+	return pk, value, nil
+}
+
+// Close implements std.ClosableIterator.
+func (i *IndexIterator) Close() {
+	std.CloseIterator(i.iter)
+}
+
+// NextValue advances the iterator, decoding the indexed value into value
+// and returning its primary key.
+func (i *IndexIterator) NextValue(value std.JSONType) (pk []byte, err error) {
+	pk, data, err := i.Next()
+	if err != nil {
+		return nil, err
+	}
+	err = value.UnmarshalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// IndexedMap is a Map whose secondary indexes are updated
+// every time a value is saved or removed.
+type IndexedMap struct {
+	primary  Map
+	newValue func() std.JSONType
+	indexes  []Index
+}
+
+// NewIndexedMap returns an IndexedMap stored under namespace.
+// newValue must return a new empty value, it is used to decode the values
+// being replaced or removed so that their index entries can be deleted.
+// The indexes must be built with namespace as pkNamespace.
+func NewIndexedMap(namespace string, newValue func() std.JSONType, indexes ...Index) IndexedMap {
+	return IndexedMap{
+		primary:  NewMap(namespace),
+		newValue: newValue,
+		indexes:  indexes,
+	}
+}
+
+// Load decodes the value stored at pk into value.
+// A types.NotFound error is returned if pk does not exist.
+func (m IndexedMap) Load(storage std.ReadonlyStorage, pk []byte, value std.JSONType) error {
+	return m.primary.Load(storage, pk, value)
+}
+
+// MayLoad decodes the value stored at pk into value, reporting whether it exists.
+func (m IndexedMap) MayLoad(storage std.ReadonlyStorage, pk []byte, value std.JSONType) (bool, error) {
+	return m.primary.MayLoad(storage, pk, value)
+}
+
+// Has reports whether pk exists in the IndexedMap.
+func (m IndexedMap) Has(storage std.ReadonlyStorage, pk []byte) bool {
+	return m.primary.Has(storage, pk)
+}
+
+// Range iterates over the primary keys in [start, end) with the given order.
+func (m IndexedMap) Range(storage std.ReadonlyStorage, start, end []byte, order std.Order) *MapIterator {
+	return m.primary.Range(storage, start, end, order)
+}
+
+// Save writes value at pk and updates all the indexes.
+// All the indexes are checked first: if one of them rejects the value,
+// the error is returned and nothing is written.
+func (m IndexedMap) Save(storage std.Storage, pk []byte, value std.JSONType) error {
+	for _, index := range m.indexes {
+		err := index.Check(storage, pk, value)
+		if err != nil {
+			return err
+		}
+	}
+	err := m.removeIndexes(storage, pk)
+	if err != nil {
+		return err
+	}
+	for _, index := range m.indexes {
+		err = index.Save(storage, pk, value)
+		if err != nil {
+			return err
+		}
+	}
+	return m.primary.Save(storage, pk, value)
+}
+
+// Remove deletes pk and its index entries.
+func (m IndexedMap) Remove(storage std.Storage, pk []byte) error {
+	err := m.removeIndexes(storage, pk)
+	if err != nil {
+		return err
+	}
+	m.primary.Remove(storage, pk)
+	return nil
+}
+
+// Update loads the value stored at pk into value, runs action on it and
+// saves the result updating the indexes. found reports whether pk existed
+// before the update. Nothing is written if action returns an error.
+func (m IndexedMap) Update(storage std.Storage, pk []byte, value std.JSONType, action func(found bool) error) error {
+	found, err := m.primary.MayLoad(storage, pk, value)
+	if err != nil {
+		return err
+	}
+	err = action(found)
+	if err != nil {
+		return err
+	}
+	return m.Save(storage, pk, value)
+}
+
+// removeIndexes deletes the index entries of the value currently stored at pk, if any.
+func (m IndexedMap) removeIndexes(storage std.Storage, pk []byte) error {
+	if len(m.indexes) == 0 {
+		return nil
+	}
+	old := m.newValue()
+	found, err := m.primary.MayLoad(storage, pk, old)
+	if err != nil || !found {
+		return err
+	}
+	for _, index := range m.indexes {
+		index.Remove(storage, pk, old)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// tokens are coins indexed by denom (multi) and by amount (unique).
+type tokens struct {
+	IndexedMap
+	byDenom  MultiIndex
+	byAmount UniqueIndex
+}
+
+func newTokens() tokens {
+	byDenom := NewMultiIndex("tokens__denom", "tokens", func(value std.JSONType) []byte {
+		return []byte(value.(*types.Coin).Denom)
+	})
+	byAmount := NewUniqueIndex("tokens__amount", "tokens", func(value std.JSONType) []byte {
+		return []byte(value.(*types.Coin).Amount.String())
+	})
+	newValue := func() std.JSONType { return new(types.Coin) }
+	return tokens{
+		IndexedMap: NewIndexedMap("tokens", newValue, byDenom, byAmount),
+		byDenom:    byDenom,
+		byAmount:   byAmount,
+	}
+}
+
+func collectPKs(t *testing.T, iter std.Iterator) []string {
+	var pks []string
+	for {
+		pk, _, err := iter.Next()
+		if errors.Is(err, std.ErrIteratorDone) {
+			return pks
+		}
+		require.NoError(t, err)
+		pks = append(pks, string(pk))
+	}
+}
+
+func TestIndexedMap(t *testing.T) {
+	store := mock.Storage()
+	m := newTokens()
+
+	require.NoError(t, m.Save(store, []byte("1"), &types.Coin{Denom: "atom", Amount: newCoin(10).Amount}))
+	require.NoError(t, m.Save(store, []byte("2"), &types.Coin{Denom: "osmo", Amount: newCoin(20).Amount}))
+	require.NoError(t, m.Save(store, []byte("3"), &types.Coin{Denom: "atom", Amount: newCoin(30).Amount}))
+
+	// multi index entries match the cw-storage-plus layout, with the JSON encoded primary key length as value
+	require.Equal(t, []byte("1"), store.Get([]byte("\x00\x0dtokens__denom\x00\x04atom1")))
+
+	// multi index
+	require.Equal(t, []string{"1", "3"}, collectPKs(t, m.byDenom.Prefix(store, []byte("atom"), nil, nil, std.Ascending)))
+	require.Equal(t, []string{"3", "1"}, collectPKs(t, m.byDenom.Prefix(store, []byte("atom"), nil, nil, std.Descending)))
+	require.Equal(t, []string{"2"}, collectPKs(t, m.byDenom.Prefix(store, []byte("osmo"), nil, nil, std.Ascending)))
+	require.Equal(t, []string{"1", "3", "2"}, collectPKs(t, m.byDenom.Range(store, nil, nil, std.Ascending)))
+	require.Equal(t, []string{"2"}, collectPKs(t, m.byDenom.Range(store, []byte("juno"), nil, std.Ascending)))
+
+	// unique index
+	coin := types.Coin{}
+	pk, err := m.byAmount.Load(store, []byte("20"), &coin)
+	require.NoError(t, err)
+	require.Equal(t, "2", string(pk))
+	require.Equal(t, "osmo", coin.Denom)
+	require.Equal(t, []string{"3", "2"}, collectPKs(t, m.byAmount.Range(store, []byte("2"), nil, std.Descending)))
+
+	// duplicates are rejected
+	err = m.Save(store, []byte("4"), &types.Coin{Denom: "juno", Amount: newCoin(10).Amount})
+	require.Equal(t, UniqueIndexErr{Index: "tokens__amount"}, err)
+
+	// rejected saves leave the values and all their index entries untouched
+	err = m.Save(store, []byte("2"), &types.Coin{Denom: "juno", Amount: newCoin(30).Amount})
+	require.Equal(t, UniqueIndexErr{Index: "tokens__amount"}, err)
+	require.NoError(t, m.Load(store, []byte("2"), &coin))
+	require.Equal(t, "osmo", coin.Denom)
+	require.Equal(t, []string{"1", "3", "2"}, collectPKs(t, m.byDenom.Range(store, nil, nil, std.Ascending)))
+	require.Equal(t, []string{"2"}, collectPKs(t, m.byDenom.Prefix(store, []byte("osmo"), nil, nil, std.Ascending)))
+	require.Equal(t, []string{"1", "2", "3"}, collectPKs(t, m.byAmount.Range(store, nil, nil, std.Ascending)))
+
+	// overwriting keeps the indexes in sync
+	require.NoError(t, m.Save(store, []byte("1"), &types.Coin{Denom: "osmo", Amount: newCoin(10).Amount}))
+	require.Equal(t, []string{"3"}, collectPKs(t, m.byDenom.Prefix(store, []byte("atom"), nil, nil, std.Ascending)))
+	require.Equal(t, []string{"1", "2"}, collectPKs(t, m.byDenom.Prefix(store, []byte("osmo"), nil, nil, std.Ascending)))
+
+	// update
+	err = m.Update(store, []byte("3"), &coin, func(found bool) error {
+		require.True(t, found)
+		coin.Amount = newCoin(40).Amount
+		return nil
+	})
+	require.NoError(t, err)
+	pk, err = m.byAmount.Load(store, []byte("30"), &coin)
+	require.NoError(t, err)
+	require.Nil(t, pk)
+	pk, err = m.byAmount.Load(store, []byte("40"), &coin)
+	require.NoError(t, err)
+	require.Equal(t, "3", string(pk))
+
+	// removal deletes the index entries
+	require.NoError(t, m.Remove(store, []byte("1")))
+	require.False(t, m.Has(store, []byte("1")))
+	require.Equal(t, []string{"2"}, collectPKs(t, m.byDenom.Prefix(store, []byte("osmo"), nil, nil, std.Ascending)))
+	require.Equal(t, []string{"2", "3"}, collectPKs(t, m.byAmount.Range(store, nil, nil, std.Ascending)))
+}
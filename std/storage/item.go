@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// Item stores a single value under a fixed key.
+type Item struct {
+	key []byte
+}
+
+// NewItem returns an Item stored under the provided key.
+func NewItem(key string) Item {
+	if len(key) == 0 {
+		panic("storage: empty item key")
+	}
+	return Item{key: []byte(key)}
+}
+
+// Key returns the storage key of the Item.
+func (i Item) Key() []byte {
+	return i.key
+}
+
+// Load decodes the stored value into value.
+// A types.NotFound error is returned if the Item was never saved.
+func (i Item) Load(storage std.ReadonlyStorage, value std.JSONType) error {
+	found, err := i.MayLoad(storage, value)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.NotFound{Kind: string(i.key)}
+	}
+	return nil
+}
+
+// MayLoad decodes the stored value into value, reporting whether it exists.
+// If it does not exist, value is left untouched.
+func (i Item) MayLoad(storage std.ReadonlyStorage, value std.JSONType) (bool, error) {
+	return mayLoad(storage, i.key, value)
+}
+
+// Has reports whether the Item was saved.
+func (i Item) Has(storage std.ReadonlyStorage) bool {
+	return storage.Get(i.key) != nil
+}
+
+// Save encodes value and writes it to storage.
+func (i Item) Save(storage std.Storage, value std.JSONType) error {
+	return save(storage, i.key, value)
+}
+
+// Remove deletes the Item from storage.
+func (i Item) Remove(storage std.Storage) {
+	storage.Remove(i.key)
+}
+
+// Update loads the current value into value, runs action on it and saves
+// the result. found reports whether a value was stored before the update.
+// Nothing is written if action returns an error.
+func (i Item) Update(storage std.Storage, value std.JSONType, action func(found bool) error) error {
+	return update(storage, i.key, value, action)
+}
+
+func mayLoad(storage std.ReadonlyStorage, key []byte, value std.JSONType) (bool, error) {
+	data := storage.Get(key)
+	if data == nil {
+		return false, nil
+	}
+	err := value.UnmarshalJSON(data)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func save(storage std.Storage, key []byte, value std.JSONType) error {
+	bz, err := value.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	storage.Set(key, bz)
+	return nil
+}
+
+func update(storage std.Storage, key []byte, value std.JSONType, action func(found bool) error) error {
+	found, err := mayLoad(storage, key, value)
+	if err != nil {
+		return err
+	}
+	err = action(found)
+	if err != nil {
+		return err
+	}
+	return save(storage, key, value)
+}
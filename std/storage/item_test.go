@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// newCoin returns an atom coin pointer, which can be used as std.JSONType.
+func newCoin(amount uint64) *types.Coin {
+	c := types.NewCoinFromUint64(amount, "atom")
+	return &c
+}
+
+func TestItem(t *testing.T) {
+	store := mock.Storage()
+	item := NewItem("config")
+
+	// empty item
+	require.False(t, item.Has(store))
+	coin := types.Coin{}
+	err := item.Load(store, &coin)
+	require.Equal(t, types.NotFound{Kind: "config"}, err)
+	found, err := item.MayLoad(store, &coin)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// save and load
+	require.NoError(t, item.Save(store, newCoin(100)))
+	require.True(t, item.Has(store))
+	require.NotNil(t, store.Get([]byte("config")))
+	require.NoError(t, item.Load(store, &coin))
+	require.Equal(t, newCoin(100), &coin)
+
+	// remove
+	item.Remove(store)
+	require.False(t, item.Has(store))
+}
+
+func TestItem_Update(t *testing.T) {
+	store := mock.Storage()
+	item := NewItem("config")
+
+	// update on empty item
+	coin := types.Coin{}
+	err := item.Update(store, &coin, func(found bool) error {
+		require.False(t, found)
+		coin = *newCoin(1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// update on existing item
+	err = item.Update(store, &coin, func(found bool) error {
+		require.True(t, found)
+		coin.Amount = coin.Amount.Add64(1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	got := types.Coin{}
+	require.NoError(t, item.Load(store, &got))
+	require.Equal(t, newCoin(2), &got)
+
+	// failing update does not write
+	err = item.Update(store, &coin, func(found bool) error {
+		coin.Amount = coin.Amount.Add64(1)
+		return types.GenericError("fail")
+	})
+	require.Error(t, err)
+	require.NoError(t, item.Load(store, &got))
+	require.Equal(t, newCoin(2), &got)
+}
@@ -0,0 +1,249 @@
+// Package keys encodes storage keys the same way cw-storage-plus does,
+// so that state written by Go contracts can be read by Rust tooling and vice versa.
+//
+// A namespace or a non-final key component is written length-prefixed,
+// that is preceded by its length as a big-endian uint16. The final key component
+// is appended as is.
+//
+// Numbers are encoded so that their byte order matches their numeric order:
+// unsigned integers are big-endian and signed integers are big-endian with the
+// sign bit flipped. Each encoder has a Parse counterpart decoding it.
+package keys
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+)
+
+var (
+	errKeyTooLong  = errors.New("keys: key component longer than 65535 bytes")
+	errShortKey    = errors.New("keys: key too short")
+	errNoComponent = errors.New("keys: at least one key component is required")
+	errKeySize     = errors.New("keys: invalid key size")
+)
+
+// LengthPrefixed returns b preceded by its length as a big-endian uint16.
+// It panics if b is longer than 65535 bytes.
+func LengthPrefixed(b []byte) []byte {
+	res := make([]byte, 0, len(b)+2)
+	return appendLengthPrefixed(res, b)
+}
+
+// Namespace returns the length-prefixed concatenation of the provided namespaces.
+// This is the prefix cw-storage-plus uses for maps and their prefixes.
+func Namespace(namespaces ...[]byte) []byte {
+	size := 0
+	for _, ns := range namespaces {
+		size += len(ns) + 2
+	}
+	res := make([]byte, 0, size)
+	for _, ns := range namespaces {
+		res = appendLengthPrefixed(res, ns)
+	}
+	return res
+}
+
+// Tuple joins the encoded components of a composite key.
+// All the components but the last one are length-prefixed.
+func Tuple(components ...[]byte) []byte {
+	if len(components) == 0 {
+		return nil
+	}
+	last := len(components) - 1
+	size := len(components[last])
+	for _, c := range components[:last] {
+		size += len(c) + 2
+	}
+	res := make([]byte, 0, size)
+	for _, c := range components[:last] {
+		res = appendLengthPrefixed(res, c)
+	}
+	return append(res, components[last]...)
+}
+
+// Split is the inverse of Tuple, it splits key into n components.
+func Split(key []byte, n int) ([][]byte, error) {
+	if n < 1 {
+		return nil, errNoComponent
+	}
+	components := make([][]byte, 0, n)
+	for i := 0; i < n-1; i++ {
+		if len(key) < 2 {
+			return nil, errShortKey
+		}
+		size := int(binary.BigEndian.Uint16(key))
+		key = key[2:]
+		if len(key) < size {
+			return nil, errShortKey
+		}
+		components = append(components, key[:size])
+		key = key[size:]
+	}
+	return append(components, key), nil
+}
+
+// ParseLengthPrefixed is the inverse of LengthPrefixed, it returns the
+// length-prefixed component at the start of key and the rest of key.
+func ParseLengthPrefixed(key []byte) (component, rest []byte, err error) {
+	components, err := Split(key, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return components[0], components[1], nil
+}
+
+// String encodes a string key. Addresses are encoded as strings.
+func String(s string) []byte {
+	return []byte(s)
+}
+
+// ParseString decodes a key encoded by String.
+func ParseString(key []byte) string {
+	return string(key)
+}
+
+// Bytes encodes a raw bytes key.
+func Bytes(b []byte) []byte {
+	return b
+}
+
+// Uint8 encodes an uint8 key.
+func Uint8(v uint8) []byte {
+	return []byte{v}
+}
+
+// Uint16 encodes an uint16 key in big-endian order.
+func Uint16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+// Uint32 encodes an uint32 key in big-endian order.
+func Uint32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+// Uint64 encodes an uint64 key in big-endian order.
+func Uint64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+// Uint128 encodes a math.Uint128 key in big-endian order.
+func Uint128(v math.Uint128) []byte {
+	b := make([]byte, math.Uint128Size)
+	v.PutBEBytes(b)
+	return b
+}
+
+// Int8 encodes an int8 key with the sign bit flipped.
+func Int8(v int8) []byte {
+	return Uint8(uint8(v) ^ 0x80)
+}
+
+// Int16 encodes an int16 key in big-endian order with the sign bit flipped.
+func Int16(v int16) []byte {
+	return Uint16(uint16(v) ^ 0x8000)
+}
+
+// Int32 encodes an int32 key in big-endian order with the sign bit flipped.
+func Int32(v int32) []byte {
+	return Uint32(uint32(v) ^ 0x80000000)
+}
+
+// Int64 encodes an int64 key in big-endian order with the sign bit flipped.
+func Int64(v int64) []byte {
+	return Uint64(uint64(v) ^ 0x8000000000000000)
+}
+
+// ParseUint8 decodes a key encoded by Uint8.
+func ParseUint8(key []byte) (uint8, error) {
+	if len(key) != 1 {
+		return 0, errKeySize
+	}
+	return key[0], nil
+}
+
+// ParseUint16 decodes a key encoded by Uint16.
+func ParseUint16(key []byte) (uint16, error) {
+	if len(key) != 2 {
+		return 0, errKeySize
+	}
+	return binary.BigEndian.Uint16(key), nil
+}
+
+// ParseUint32 decodes a key encoded by Uint32.
+func ParseUint32(key []byte) (uint32, error) {
+	if len(key) != 4 {
+		return 0, errKeySize
+	}
+	return binary.BigEndian.Uint32(key), nil
+}
+
+// ParseUint64 decodes a key encoded by Uint64.
+func ParseUint64(key []byte) (uint64, error) {
+	if len(key) != 8 {
+		return 0, errKeySize
+	}
+	return binary.BigEndian.Uint64(key), nil
+}
+
+// ParseUint128 decodes a key encoded by Uint128.
+func ParseUint128(key []byte) (math.Uint128, error) {
+	v := math.Uint128{}
+	err := v.FromBEBytes(key)
+	if err != nil {
+		return math.Uint128{}, errKeySize
+	}
+	return v, nil
+}
+
+// ParseInt8 decodes a key encoded by Int8.
+func ParseInt8(key []byte) (int8, error) {
+	v, err := ParseUint8(key)
+	if err != nil {
+		return 0, err
+	}
+	return int8(v ^ 0x80), nil
+}
+
+// ParseInt16 decodes a key encoded by Int16.
+func ParseInt16(key []byte) (int16, error) {
+	v, err := ParseUint16(key)
+	if err != nil {
+		return 0, err
+	}
+	return int16(v ^ 0x8000), nil
+}
+
+// ParseInt32 decodes a key encoded by Int32.
+func ParseInt32(key []byte) (int32, error) {
+	v, err := ParseUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return int32(v ^ 0x80000000), nil
+}
+
+// ParseInt64 decodes a key encoded by Int64.
+func ParseInt64(key []byte) (int64, error) {
+	v, err := ParseUint64(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ 0x8000000000000000), nil
+}
+
+func appendLengthPrefixed(dst, b []byte) []byte {
+	if len(b) > 0xFFFF {
+		panic(errKeyTooLong)
+	}
+	dst = append(dst, byte(len(b)>>8), byte(len(b)))
+	return append(dst, b...)
+}
@@ -0,0 +1,129 @@
+package keys
+
+import (
+	"bytes"
+	stdmath "math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+)
+
+// the expected values are taken from cw-storage-plus tests and helpers.
+func TestEncoding(t *testing.T) {
+	require.Equal(t, []byte("\x00\x06config"), LengthPrefixed([]byte("config")))
+	require.Equal(t, []byte("\x00\x00"), LengthPrefixed(nil))
+	require.Equal(t, []byte("\x00\x05allow\x00\x05owner"), Namespace([]byte("allow"), []byte("owner")))
+	require.Equal(t, []byte("\x00\x05ownerspender"), Tuple([]byte("owner"), []byte("spender")))
+	require.Equal(t, []byte("\x00\x01a\x00\x02bbccc"), Tuple(String("a"), String("bb"), String("ccc")))
+	require.Equal(t, []byte("single"), Tuple(Bytes([]byte("single"))))
+	require.Nil(t, Tuple())
+
+	require.Equal(t, []byte{0x2a}, Uint8(42))
+	require.Equal(t, []byte{0x04, 0xd2}, Uint16(1234))
+	require.Equal(t, []byte{0x00, 0x00, 0x04, 0xd2}, Uint32(1234))
+	require.Equal(t, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0xd2}, Uint64(1234))
+	require.Equal(t, []byte("\x00\x04john\x00\x00\x00\x00\x00\x00\x00\x01"), Tuple(String("john"), Uint64(1)))
+
+	require.Panics(t, func() { LengthPrefixed(make([]byte, 0x10000)) })
+}
+
+func TestSplit(t *testing.T) {
+	components, err := Split(Tuple(String("a"), String("bb"), String("ccc")), 3)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("bb"), []byte("ccc")}, components)
+
+	// the last component takes the rest of the key
+	components, err = Split(Tuple(String("a"), String("bb"), String("ccc")), 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("\x00\x02bbccc")}, components)
+
+	_, err = Split([]byte{0x00}, 2)
+	require.Error(t, err)
+	_, err = Split([]byte{0x00, 0x05, 'a'}, 2)
+	require.Error(t, err)
+	_, err = Split([]byte("a"), 0)
+	require.Error(t, err)
+}
+
+func TestNumbers(t *testing.T) {
+	// signed integers are encoded like cw-storage-plus does
+	require.Equal(t, []byte{0x7f, 0xff, 0xff, 0xff}, Int32(-1))
+	require.Equal(t, []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, Int64(1))
+	require.Equal(t, []byte{0x00}, Int8(-128))
+	require.Equal(t, []byte{0x80, 0x00}, Int16(0))
+	require.Equal(t,
+		[]byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2},
+		Uint128(math.NewUint128(2, 1)))
+
+	// byte order matches numeric order
+	ints := []int64{stdmath.MinInt64, -1000, -1, 0, 1, 255, 256, stdmath.MaxInt64}
+	for i := 1; i < len(ints); i++ {
+		require.Equal(t, -1, bytes.Compare(Int64(ints[i-1]), Int64(ints[i])), "%d < %d", ints[i-1], ints[i])
+	}
+	int32s := []int32{stdmath.MinInt32, -1, 0, 1, stdmath.MaxInt32}
+	for i := 1; i < len(int32s); i++ {
+		require.Equal(t, -1, bytes.Compare(Int32(int32s[i-1]), Int32(int32s[i])), "%d < %d", int32s[i-1], int32s[i])
+	}
+	amounts := []math.Uint128{math.ZeroUint128(), math.NewUint128FromUint64(255), math.NewUint128FromUint64(256), math.NewUint128(0, 1), math.NewUint128(5, 1)}
+	for i := 1; i < len(amounts); i++ {
+		require.Equal(t, -1, bytes.Compare(Uint128(amounts[i-1]), Uint128(amounts[i])))
+	}
+
+	// decoding is the inverse of encoding
+	for _, v := range ints {
+		decoded, err := ParseInt64(Int64(v))
+		require.NoError(t, err)
+		require.Equal(t, v, decoded)
+	}
+	for _, v := range int32s {
+		decoded, err := ParseInt32(Int32(v))
+		require.NoError(t, err)
+		require.Equal(t, v, decoded)
+	}
+	for _, v := range []int16{stdmath.MinInt16, 0, stdmath.MaxInt16} {
+		decoded, err := ParseInt16(Int16(v))
+		require.NoError(t, err)
+		require.Equal(t, v, decoded)
+	}
+	i8, err := ParseInt8(Int8(-5))
+	require.NoError(t, err)
+	require.Equal(t, int8(-5), i8)
+	u8, err := ParseUint8(Uint8(5))
+	require.NoError(t, err)
+	require.Equal(t, uint8(5), u8)
+	u16, err := ParseUint16(Uint16(1234))
+	require.NoError(t, err)
+	require.Equal(t, uint16(1234), u16)
+	u32, err := ParseUint32(Uint32(1234))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1234), u32)
+	u64, err := ParseUint64(Uint64(1234))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1234), u64)
+	for _, v := range amounts {
+		decoded, err := ParseUint128(Uint128(v))
+		require.NoError(t, err)
+		require.Equal(t, v, decoded)
+	}
+
+	_, err = ParseInt64([]byte{1, 2, 3})
+	require.Error(t, err)
+	_, err = ParseUint128(Uint64(1))
+	require.Error(t, err)
+}
+
+func TestStrings(t *testing.T) {
+	require.Equal(t, "alice", ParseString(String("alice")))
+
+	component, rest, err := ParseLengthPrefixed(Tuple(String("alice"), Int64(-1)))
+	require.NoError(t, err)
+	require.Equal(t, "alice", ParseString(component))
+	v, err := ParseInt64(rest)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), v)
+
+	_, _, err = ParseLengthPrefixed([]byte{0x00, 0x05, 'a'})
+	require.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/storage/keys"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// Map stores values keyed by arbitrary bytes under a namespace.
+// Keys are stored as the length-prefixed namespace followed by the key,
+// which matches the layout of cw-storage-plus maps. Composite keys
+// are built with keys.Tuple.
+type Map struct {
+	prefix []byte
+}
+
+// NewMap returns a Map whose keys live under the provided namespace.
+func NewMap(namespace string) Map {
+	if len(namespace) == 0 {
+		panic("storage: empty map namespace")
+	}
+	return Map{prefix: keys.Namespace([]byte(namespace))}
+}
+
+// Prefix returns the Map of the keys starting with the provided components
+// of a composite key. Keys of the returned Map are the remaining components.
+func (m Map) Prefix(components ...[]byte) Map {
+	return Map{prefix: append(m.Key(nil), keys.Namespace(components...)...)}
+}
+
+// Key returns the full storage key of the provided map key.
+func (m Map) Key(key []byte) []byte {
+	fullKey := make([]byte, 0, len(m.prefix)+len(key))
+	fullKey = append(fullKey, m.prefix...)
+	return append(fullKey, key...)
+}
+
+// Load decodes the value stored at key into value.
+// A types.NotFound error is returned if the key does not exist.
+func (m Map) Load(storage std.ReadonlyStorage, key []byte, value std.JSONType) error {
+	found, err := m.MayLoad(storage, key, value)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.NotFound{Kind: string(key)}
+	}
+	return nil
+}
+
+// MayLoad decodes the value stored at key into value, reporting whether it exists.
+// If it does not exist, value is left untouched.
+func (m Map) MayLoad(storage std.ReadonlyStorage, key []byte, value std.JSONType) (bool, error) {
+	return mayLoad(storage, m.Key(key), value)
+}
+
+// Has reports whether key exists in the Map.
+func (m Map) Has(storage std.ReadonlyStorage, key []byte) bool {
+	return storage.Get(m.Key(key)) != nil
+}
+
+// Save encodes value and writes it at key.
+func (m Map) Save(storage std.Storage, key []byte, value std.JSONType) error {
+	return save(storage, m.Key(key), value)
+}
+
+// Remove deletes key from the Map.
+func (m Map) Remove(storage std.Storage, key []byte) {
+	storage.Remove(m.Key(key))
+}
+
+// Update loads the value stored at key into value, runs action on it and
+// saves the result. found reports whether key existed before the update.
+// Nothing is written if action returns an error.
+func (m Map) Update(storage std.Storage, key []byte, value std.JSONType, action func(found bool) error) error {
+	return update(storage, m.Key(key), value, action)
+}
+
+// Range iterates over the Map keys in [start, end) with the given order.
+// A nil start or end leaves that side of the range unbounded within the Map.
+// The returned iterator yields keys without the namespace.
+func (m Map) Range(storage std.ReadonlyStorage, start, end []byte, order std.Order) *MapIterator {
+	rangeStart := m.prefix
+	if start != nil {
+		rangeStart = m.Key(start)
+	}
+	rangeEnd := prefixEnd(m.prefix)
+	if end != nil {
+		rangeEnd = m.Key(end)
+	}
+	return &MapIterator{
+		iter:      storage.Range(rangeStart, rangeEnd, order),
+		prefixLen: len(m.prefix),
+	}
+}
+
+var _ std.ClosableIterator = (*MapIterator)(nil)
+
+// MapIterator iterates over the entries of a Map.
+type MapIterator struct {
+	iter      std.Iterator
+	prefixLen int
+}
+
+// Next implements std.Iterator, returning the key without the Map namespace
+// and the raw value.
+func (i *MapIterator) Next() (key, value []byte, err error) {
+	key, value, err = i.iter.Next()
+	if err != nil {
+		return nil, nil, err
+	}
+	return key[i.prefixLen:], value, nil
+}
+
+// Close implements std.ClosableIterator.
+func (i *MapIterator) Close() {
+	std.CloseIterator(i.iter)
+}
+
+// NextValue advances the iterator, decoding the value into value and returning its key.
+func (i *MapIterator) NextValue(value std.JSONType) (key []byte, err error) {
+	key, data, err := i.Next()
+	if err != nil {
+		return nil, err
+	}
+	err = value.UnmarshalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// prefixEnd returns the smallest key which is bigger than all the keys starting
+// with prefix, or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
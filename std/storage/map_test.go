@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/storage/keys"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func TestMap(t *testing.T) {
+	store := mock.Storage()
+	balances := NewMap("balances")
+
+	require.Equal(t, []byte("\x00\x08balancesalice"), balances.Key([]byte("alice")))
+
+	coin := types.Coin{}
+	require.False(t, balances.Has(store, []byte("alice")))
+	err := balances.Load(store, []byte("alice"), &coin)
+	require.Equal(t, types.NotFound{Kind: "alice"}, err)
+
+	require.NoError(t, balances.Save(store, []byte("alice"), newCoin(10)))
+	require.True(t, balances.Has(store, []byte("alice")))
+	require.NoError(t, balances.Load(store, []byte("alice"), &coin))
+	require.Equal(t, newCoin(10), &coin)
+
+	err = balances.Update(store, []byte("alice"), &coin, func(found bool) error {
+		require.True(t, found)
+		coin.Amount = coin.Amount.Add64(5)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, balances.Load(store, []byte("alice"), &coin))
+	require.Equal(t, newCoin(15), &coin)
+
+	balances.Remove(store, []byte("alice"))
+	require.False(t, balances.Has(store, []byte("alice")))
+}
+
+func TestMap_Range(t *testing.T) {
+	store := mock.Storage()
+	balances := NewMap("balances")
+	other := NewMap("balances2")
+
+	// keys outside the namespace must never be returned
+	store.Set([]byte("a"), []byte("{}"))
+	store.Set([]byte("z"), []byte("{}"))
+	require.NoError(t, other.Save(store, []byte("a"), newCoin(0)))
+
+	names := []string{"alice", "bob", "carl"}
+	for i, name := range names {
+		require.NoError(t, balances.Save(store, []byte(name), newCoin(uint64(i))))
+	}
+
+	// ascending, unbounded
+	iter := balances.Range(store, nil, nil, std.Ascending)
+	for i, name := range names {
+		coin := types.Coin{}
+		key, err := iter.NextValue(&coin)
+		require.NoError(t, err)
+		require.Equal(t, name, string(key))
+		require.Equal(t, newCoin(uint64(i)), &coin)
+	}
+	_, _, err := iter.Next()
+	require.Equal(t, std.ErrIteratorDone, err)
+
+	// descending, bounded
+	iter = balances.Range(store, []byte("b"), []byte("carl"), std.Descending)
+	key, _, err := iter.Next()
+	require.NoError(t, err)
+	require.Equal(t, "bob", string(key))
+	_, _, err = iter.Next()
+	require.Equal(t, std.ErrIteratorDone, err)
+}
+
+func TestMap_CompositeKeys(t *testing.T) {
+	store := mock.Storage()
+	allowances := NewMap("allow")
+
+	key := keys.Tuple(keys.String("owner"), keys.String("spender"))
+	require.NoError(t, allowances.Save(store, key, newCoin(1)))
+	require.NoError(t, allowances.Save(store, keys.Tuple(keys.String("owner"), keys.String("spender2")), newCoin(2)))
+	require.NoError(t, allowances.Save(store, keys.Tuple(keys.String("owner2"), keys.String("spender")), newCoin(3)))
+
+	// layout matches cw-storage-plus
+	require.NotNil(t, store.Get([]byte("\x00\x05allow\x00\x05ownerspender")))
+
+	// prefix iteration only returns the keys of the owner
+	owner := allowances.Prefix(keys.String("owner"))
+	coin := types.Coin{}
+	require.NoError(t, owner.Load(store, keys.String("spender2"), &coin))
+	require.Equal(t, newCoin(2), &coin)
+
+	iter := owner.Range(store, nil, nil, std.Descending)
+	key, err := iter.NextValue(&coin)
+	require.NoError(t, err)
+	require.Equal(t, "spender2", string(key))
+	key, err = iter.NextValue(&coin)
+	require.NoError(t, err)
+	require.Equal(t, "spender", string(key))
+	require.Equal(t, newCoin(1), &coin)
+	_, err = iter.NextValue(&coin)
+	require.Equal(t, std.ErrIteratorDone, err)
+}
+
+func TestPrefixEnd(t *testing.T) {
+	require.Equal(t, []byte{0x01, 0x03}, prefixEnd([]byte{0x01, 0x02}))
+	require.Equal(t, []byte{0x02}, prefixEnd([]byte{0x01, 0xFF}))
+	require.Nil(t, prefixEnd([]byte{0xFF, 0xFF}))
+}
+
+func TestMap_NumericKeys(t *testing.T) {
+	store := mock.Storage()
+	events := NewMap("events")
+	for _, ts := range []int64{300, -20, 5, 1000, -1} {
+		require.NoError(t, events.Save(store, keys.Int64(ts), newCoin(uint64(ts+100))))
+	}
+
+	var timestamps []int64
+	iter := events.Range(store, keys.Int64(-1), keys.Int64(1000), std.Ascending)
+	for {
+		key, _, err := iter.Next()
+		if err != nil {
+			require.Equal(t, std.ErrIteratorDone, err)
+			break
+		}
+		ts, err := keys.ParseInt64(key)
+		require.NoError(t, err)
+		timestamps = append(timestamps, ts)
+	}
+	require.Equal(t, []int64{-1, 5, 300}, timestamps)
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/storage/keys"
+)
+
+var (
+	_ std.Storage         = PrefixedStorage{}
+	_ std.ReadonlyStorage = ReadonlyPrefixedStorage{}
+)
+
+// ReadonlyPrefixedStorage is a std.ReadonlyStorage confined to a namespace.
+// Keys are stored as the length-prefixed namespace followed by the key,
+// which matches the layout of cosmwasm-storage prefixed storages.
+type ReadonlyPrefixedStorage struct {
+	storage std.ReadonlyStorage
+	prefix  []byte
+}
+
+// NewReadonlyPrefixedStorage returns a read only view of the keys of storage
+// living under the provided namespace.
+func NewReadonlyPrefixedStorage(storage std.ReadonlyStorage, namespace string) ReadonlyPrefixedStorage {
+	if len(namespace) == 0 {
+		panic("storage: empty storage namespace")
+	}
+	return ReadonlyPrefixedStorage{
+		storage: storage,
+		prefix:  keys.Namespace([]byte(namespace)),
+	}
+}
+
+// Get implements std.ReadonlyStorage.
+func (s ReadonlyPrefixedStorage) Get(key []byte) []byte {
+	return s.storage.Get(prefixedKey(s.prefix, key))
+}
+
+// Range implements std.ReadonlyStorage. A nil start or end leaves that side
+// of the range unbounded within the namespace. The returned iterator yields
+// keys without the namespace.
+func (s ReadonlyPrefixedStorage) Range(start, end []byte, order std.Order) std.Iterator {
+	return prefixedRange(s.storage, s.prefix, start, end, order)
+}
+
+// PrefixedStorage is a std.Storage confined to a namespace, it allows
+// handing independent components their own storage inside one contract.
+// Keys are stored as the length-prefixed namespace followed by the key,
+// which matches the layout of cosmwasm-storage prefixed storages.
+type PrefixedStorage struct {
+	storage std.Storage
+	prefix  []byte
+}
+
+// NewPrefixedStorage returns a view of the keys of storage living under
+// the provided namespace. PrefixedStorage can be nested.
+func NewPrefixedStorage(storage std.Storage, namespace string) PrefixedStorage {
+	if len(namespace) == 0 {
+		panic("storage: empty storage namespace")
+	}
+	return PrefixedStorage{
+		storage: storage,
+		prefix:  keys.Namespace([]byte(namespace)),
+	}
+}
+
+// Get implements std.Storage.
+func (s PrefixedStorage) Get(key []byte) []byte {
+	return s.storage.Get(prefixedKey(s.prefix, key))
+}
+
+// Range implements std.Storage. A nil start or end leaves that side
+// of the range unbounded within the namespace. The returned iterator yields
+// keys without the namespace.
+func (s PrefixedStorage) Range(start, end []byte, order std.Order) std.Iterator {
+	return prefixedRange(s.storage, s.prefix, start, end, order)
+}
+
+// Set implements std.Storage.
+func (s PrefixedStorage) Set(key, value []byte) {
+	s.storage.Set(prefixedKey(s.prefix, key), value)
+}
+
+// Remove implements std.Storage.
+func (s PrefixedStorage) Remove(key []byte) {
+	s.storage.Remove(prefixedKey(s.prefix, key))
+}
+
+func prefixedKey(prefix, key []byte) []byte {
+	fullKey := make([]byte, 0, len(prefix)+len(key))
+	fullKey = append(fullKey, prefix...)
+	return append(fullKey, key...)
+}
+
+func prefixedRange(storage std.ReadonlyStorage, prefix, start, end []byte, order std.Order) std.Iterator {
+	return Map{prefix: prefix}.Range(storage, start, end, order)
+}
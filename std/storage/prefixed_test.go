@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+)
+
+func TestPrefixedStorage(t *testing.T) {
+	store := mock.Storage()
+	// keys around the namespace must never be visible
+	store.Set([]byte("\x00\x03fo"), []byte("before"))
+	store.Set([]byte("\x00\x03fop"), []byte("after"))
+
+	foo := NewPrefixedStorage(store, "foo")
+	foo.Set([]byte("a"), []byte("1"))
+	foo.Set([]byte("b"), []byte("2"))
+	foo.Set([]byte("c"), []byte("3"))
+	require.Equal(t, []byte("1"), store.Get([]byte("\x00\x03fooa")))
+	require.Equal(t, []byte("2"), foo.Get([]byte("b")))
+	require.Nil(t, foo.Get([]byte("d")))
+
+	require.Equal(t, []string{"a", "b", "c"}, collectPKs(t, foo.Range(nil, nil, std.Ascending)))
+	require.Equal(t, []string{"c", "b", "a"}, collectPKs(t, foo.Range(nil, nil, std.Descending)))
+	require.Equal(t, []string{"b"}, collectPKs(t, foo.Range([]byte("b"), []byte("c"), std.Ascending)))
+	require.Equal(t, []string{"c", "b"}, collectPKs(t, foo.Range([]byte("b"), nil, std.Descending)))
+
+	foo.Remove([]byte("b"))
+	require.Nil(t, store.Get([]byte("\x00\x03foob")))
+
+	// nested namespaces are confined to their parent
+	bar := NewPrefixedStorage(foo, "bar")
+	bar.Set([]byte("x"), []byte("4"))
+	require.Equal(t, []byte("4"), store.Get([]byte("\x00\x03foo\x00\x03barx")))
+	require.Equal(t, []string{"x"}, collectPKs(t, bar.Range(nil, nil, std.Ascending)))
+
+	readonly := NewReadonlyPrefixedStorage(store, "foo")
+	require.Equal(t, []byte("3"), readonly.Get([]byte("c")))
+	require.Equal(t, []string{"\x00\x03barx", "a", "c"}, collectPKs(t, readonly.Range(nil, nil, std.Ascending)))
+}
@@ -0,0 +1,362 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/storage/keys"
+)
+
+// SnapshotStrategy defines which changes of a snapshot collection are recorded.
+type SnapshotStrategy uint8
+
+const (
+	// Always records every change, so that values can be loaded at any height.
+	Always SnapshotStrategy = 1
+	// Never records changes, values can only be loaded at the current height.
+	Never SnapshotStrategy = 2
+	// Selected records changes only while at least one checkpoint exists,
+	// values can be loaded at checkpointed heights.
+	Selected SnapshotStrategy = 3
+)
+
+// NotCheckpointedErr is returned when loading a value at a height
+// which was not recorded by the snapshot strategy.
+type NotCheckpointedErr struct {
+	Height uint64
+}
+
+func (e NotCheckpointedErr) Error() string {
+	return "Height " + strconv.FormatUint(e.Height, 10) + " is not checkpointed"
+}
+
+const (
+	changeRemoved byte = 0
+	changeSet     byte = 1
+)
+
+// snapshot records the changes of values in a changelog keyed by block height.
+// Each changelog entry holds the value as it was at the end of that block.
+type snapshot struct {
+	checkpoints Map
+	changelog   Map
+	strategy    SnapshotStrategy
+}
+
+func newSnapshot(checkpoints, changelog string, strategy SnapshotStrategy) snapshot {
+	return snapshot{
+		checkpoints: NewMap(checkpoints),
+		changelog:   NewMap(changelog),
+		strategy:    strategy,
+	}
+}
+
+// AddCheckpoint marks height as a height values can be loaded at.
+// Checkpoints are reference counted, each AddCheckpoint must be matched by a RemoveCheckpoint.
+func (s snapshot) AddCheckpoint(storage std.Storage, height uint64) {
+	key := s.checkpoints.Key(keys.Uint64(height))
+	storage.Set(key, keys.Uint32(s.checkpointCount(storage, key)+1))
+}
+
+// RemoveCheckpoint releases a checkpoint added with AddCheckpoint.
+func (s snapshot) RemoveCheckpoint(storage std.Storage, height uint64) {
+	key := s.checkpoints.Key(keys.Uint64(height))
+	count := s.checkpointCount(storage, key)
+	if count <= 1 {
+		storage.Remove(key)
+		return
+	}
+	storage.Set(key, keys.Uint32(count-1))
+}
+
+func (s snapshot) checkpointCount(storage std.ReadonlyStorage, key []byte) uint32 {
+	count := storage.Get(key)
+	if len(count) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(count)
+}
+
+func (s snapshot) shouldRecord(storage std.ReadonlyStorage) (bool, error) {
+	switch s.strategy {
+	case Always:
+		return true, nil
+	case Selected:
+		checkpoint, _, err := std.First(s.checkpoints.Range(storage, nil, nil, std.Ascending))
+		return checkpoint != nil, err
+	default:
+		return false, nil
+	}
+}
+
+func (s snapshot) assertCheckpointed(storage std.ReadonlyStorage, height uint64) error {
+	switch s.strategy {
+	case Always:
+		return nil
+	case Selected:
+		if s.checkpointCount(storage, s.checkpoints.Key(keys.Uint64(height))) > 0 {
+			return nil
+		}
+	}
+	return NotCheckpointedErr{Height: height}
+}
+
+// record writes the change of a value from before to after at height to the
+// changelog log. A nil value means the value does not exist.
+func (s snapshot) record(storage std.Storage, log Map, before, after []byte, height uint64) error {
+	record, err := s.shouldRecord(storage)
+	if err != nil || !record {
+		return err
+	}
+	if storage.Get(log.Key(keys.Uint64(height))) == nil {
+		err = s.recordBefore(storage, log, before, height)
+		if err != nil {
+			return err
+		}
+	}
+	storage.Set(log.Key(keys.Uint64(height)), encodeChange(after))
+	return nil
+}
+
+// recordBefore makes sure that the changelog log knows the value before the
+// first change of the block at height, as changes are not recorded while
+// the strategy does not require it.
+func (s snapshot) recordBefore(storage std.Storage, log Map, before []byte, height uint64) error {
+	beforeChange := encodeChange(before)
+	key, latest, err := std.First(log.Range(storage, nil, keys.Uint64(height), std.Descending))
+	switch {
+	case err != nil:
+		return err
+	case key == nil:
+		// first recorded change, the previous heights held before.
+		if before != nil && height > 0 {
+			storage.Set(log.Key(keys.Uint64(0)), beforeChange)
+		}
+	case !bytes.Equal(latest, beforeChange):
+		// the value changed while not recording, any checkpoint after the latest
+		// recorded change was added after that, so it must see before.
+		latestHeight := binary.BigEndian.Uint64(key)
+		seedHeight := latestHeight + 1
+		if seedHeight == height {
+			seedHeight = latestHeight
+		}
+		storage.Set(log.Key(keys.Uint64(seedHeight)), beforeChange)
+	}
+	return nil
+}
+
+// loadAt returns the value recorded in the changelog log at the end of the
+// block at height. current is the value stored now.
+func (s snapshot) loadAt(storage std.ReadonlyStorage, log Map, current []byte, height uint64) ([]byte, error) {
+	err := s.assertCheckpointed(storage, height)
+	if err != nil {
+		return nil, err
+	}
+	var end []byte
+	if height < math.MaxUint64 {
+		end = keys.Uint64(height + 1)
+	}
+	// the latest change up to height holds the value.
+	key, change, err := std.First(log.Range(storage, nil, end, std.Descending))
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		return decodeChange(change), nil
+	}
+	// nothing was recorded up to height, if the value was changed
+	// afterwards it did not exist yet.
+	key, _, err = std.First(log.Range(storage, end, nil, std.Ascending))
+	if err != nil || key != nil {
+		return nil, err
+	}
+	// the value was never changed while recording.
+	return current, nil
+}
+
+func encodeChange(value []byte) []byte {
+	if value == nil {
+		return []byte{changeRemoved}
+	}
+	change := make([]byte, 0, len(value)+1)
+	change = append(change, changeSet)
+	return append(change, value...)
+}
+
+func decodeChange(change []byte) []byte {
+	if len(change) == 0 || change[0] != changeSet {
+		return nil
+	}
+	return change[1:]
+}
+
+// SnapshotItem is an Item which can be loaded as it was at a past block height.
+type SnapshotItem struct {
+	snapshot
+	item Item
+}
+
+// NewSnapshotItem returns a SnapshotItem stored under key, recording its
+// checkpoints and changelog under the provided namespaces.
+func NewSnapshotItem(key, checkpoints, changelog string, strategy SnapshotStrategy) SnapshotItem {
+	return SnapshotItem{
+		snapshot: newSnapshot(checkpoints, changelog, strategy),
+		item:     NewItem(key),
+	}
+}
+
+// Load decodes the current value into value.
+// A types.NotFound error is returned if the Item does not exist.
+func (i SnapshotItem) Load(storage std.ReadonlyStorage, value std.JSONType) error {
+	return i.item.Load(storage, value)
+}
+
+// MayLoad decodes the current value into value, reporting whether it exists.
+func (i SnapshotItem) MayLoad(storage std.ReadonlyStorage, value std.JSONType) (bool, error) {
+	return i.item.MayLoad(storage, value)
+}
+
+// Has reports whether the Item currently exists.
+func (i SnapshotItem) Has(storage std.ReadonlyStorage) bool {
+	return i.item.Has(storage)
+}
+
+// LoadAt decodes the value as it was at the end of the block at height into value,
+// reporting whether it existed. NotCheckpointedErr is returned if the strategy
+// did not record that height.
+func (i SnapshotItem) LoadAt(storage std.ReadonlyStorage, height uint64, value std.JSONType) (bool, error) {
+	data, err := i.loadAt(storage, i.changelog, storage.Get(i.item.key), height)
+	if err != nil || data == nil {
+		return false, err
+	}
+	return true, value.UnmarshalJSON(data)
+}
+
+// Save writes value at height, which is usually types.Env Block.Height.
+func (i SnapshotItem) Save(storage std.Storage, value std.JSONType, height uint64) error {
+	data, err := value.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	err = i.record(storage, i.changelog, storage.Get(i.item.key), data, height)
+	if err != nil {
+		return err
+	}
+	storage.Set(i.item.key, data)
+	return nil
+}
+
+// Remove deletes the Item at height.
+func (i SnapshotItem) Remove(storage std.Storage, height uint64) error {
+	err := i.record(storage, i.changelog, storage.Get(i.item.key), nil, height)
+	if err != nil {
+		return err
+	}
+	i.item.Remove(storage)
+	return nil
+}
+
+// Update loads the current value into value, runs action on it and saves
+// the result at height. Nothing is written if action returns an error.
+func (i SnapshotItem) Update(storage std.Storage, value std.JSONType, height uint64, action func(found bool) error) error {
+	found, err := i.item.MayLoad(storage, value)
+	if err != nil {
+		return err
+	}
+	err = action(found)
+	if err != nil {
+		return err
+	}
+	return i.Save(storage, value, height)
+}
+
+// SnapshotMap is a Map whose values can be loaded as they were at a past block height.
+type SnapshotMap struct {
+	snapshot
+	primary Map
+}
+
+// NewSnapshotMap returns a SnapshotMap stored under namespace, recording its
+// checkpoints and changelog under the provided namespaces.
+// The changelog is keyed by the map key and the height joined by keys.Tuple.
+func NewSnapshotMap(namespace, checkpoints, changelog string, strategy SnapshotStrategy) SnapshotMap {
+	return SnapshotMap{
+		snapshot: newSnapshot(checkpoints, changelog, strategy),
+		primary:  NewMap(namespace),
+	}
+}
+
+// Load decodes the current value stored at key into value.
+// A types.NotFound error is returned if key does not exist.
+func (m SnapshotMap) Load(storage std.ReadonlyStorage, key []byte, value std.JSONType) error {
+	return m.primary.Load(storage, key, value)
+}
+
+// MayLoad decodes the current value stored at key into value, reporting whether it exists.
+func (m SnapshotMap) MayLoad(storage std.ReadonlyStorage, key []byte, value std.JSONType) (bool, error) {
+	return m.primary.MayLoad(storage, key, value)
+}
+
+// Has reports whether key currently exists.
+func (m SnapshotMap) Has(storage std.ReadonlyStorage, key []byte) bool {
+	return m.primary.Has(storage, key)
+}
+
+// Range iterates over the current keys in [start, end) with the given order.
+func (m SnapshotMap) Range(storage std.ReadonlyStorage, start, end []byte, order std.Order) *MapIterator {
+	return m.primary.Range(storage, start, end, order)
+}
+
+// LoadAt decodes the value stored at key as it was at the end of the block at
+// height into value, reporting whether it existed. NotCheckpointedErr is returned
+// if the strategy did not record that height.
+func (m SnapshotMap) LoadAt(storage std.ReadonlyStorage, key []byte, height uint64, value std.JSONType) (bool, error) {
+	data, err := m.loadAt(storage, m.changelog.Prefix(key), storage.Get(m.primary.Key(key)), height)
+	if err != nil || data == nil {
+		return false, err
+	}
+	return true, value.UnmarshalJSON(data)
+}
+
+// Save writes value at key at height, which is usually types.Env Block.Height.
+func (m SnapshotMap) Save(storage std.Storage, key []byte, value std.JSONType, height uint64) error {
+	data, err := value.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	primaryKey := m.primary.Key(key)
+	err = m.record(storage, m.changelog.Prefix(key), storage.Get(primaryKey), data, height)
+	if err != nil {
+		return err
+	}
+	storage.Set(primaryKey, data)
+	return nil
+}
+
+// Remove deletes key at height.
+func (m SnapshotMap) Remove(storage std.Storage, key []byte, height uint64) error {
+	primaryKey := m.primary.Key(key)
+	err := m.record(storage, m.changelog.Prefix(key), storage.Get(primaryKey), nil, height)
+	if err != nil {
+		return err
+	}
+	storage.Remove(primaryKey)
+	return nil
+}
+
+// Update loads the current value stored at key into value, runs action on it
+// and saves the result at height. Nothing is written if action returns an error.
+func (m SnapshotMap) Update(storage std.Storage, key []byte, value std.JSONType, height uint64, action func(found bool) error) error {
+	found, err := m.primary.MayLoad(storage, key, value)
+	if err != nil {
+		return err
+	}
+	err = action(found)
+	if err != nil {
+		return err
+	}
+	return m.Save(storage, key, value, height)
+}
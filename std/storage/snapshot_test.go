@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// amountAt returns the amount stored at height, or -1 if it did not exist.
+func amountAt(t *testing.T, load func(height uint64, value std.JSONType) (bool, error), height uint64) int64 {
+	coin := types.Coin{}
+	found, err := load(height, &coin)
+	require.NoError(t, err)
+	if !found {
+		return -1
+	}
+	return int64(coin.Amount.Lo)
+}
+
+func TestSnapshotMap_Always(t *testing.T) {
+	store := mock.Storage()
+	m := NewSnapshotMap("power", "power__checkpoints", "power__changelog", Always)
+	key := []byte("alice")
+	loadAt := func(height uint64, value std.JSONType) (bool, error) {
+		return m.LoadAt(store, key, height, value)
+	}
+
+	require.NoError(t, m.Save(store, key, newCoin(1), 10))
+	require.NoError(t, m.Save(store, key, newCoin(2), 10))
+	require.NoError(t, m.Save(store, key, newCoin(3), 20))
+	require.NoError(t, m.Remove(store, key, 30))
+	require.NoError(t, m.Save(store, key, newCoin(4), 40))
+
+	// another key does not interfere
+	require.NoError(t, m.Save(store, []byte("bob"), newCoin(100), 25))
+
+	expected := map[uint64]int64{0: -1, 9: -1, 10: 2, 15: 2, 20: 3, 29: 3, 30: -1, 39: -1, 40: 4, 1000: 4}
+	for height, amount := range expected {
+		require.Equal(t, amount, amountAt(t, loadAt, height), "height %d", height)
+	}
+
+	coin := types.Coin{}
+	require.NoError(t, m.Load(store, key, &coin))
+	require.Equal(t, newCoin(4), &coin)
+}
+
+func TestSnapshotItem_Selected(t *testing.T) {
+	store := mock.Storage()
+	item := NewSnapshotItem("total", "total__checkpoints", "total__changelog", Selected)
+	loadAt := func(height uint64, value std.JSONType) (bool, error) {
+		return item.LoadAt(store, height, value)
+	}
+
+	// not recorded, as no checkpoint exists
+	require.NoError(t, item.Save(store, newCoin(1), 5))
+	require.NoError(t, item.Save(store, newCoin(2), 8))
+
+	_, err := item.LoadAt(store, 8, &types.Coin{})
+	require.Equal(t, NotCheckpointedErr{Height: 8}, err)
+
+	// the value did not change since the checkpoint
+	item.AddCheckpoint(store, 10)
+	require.Equal(t, int64(2), amountAt(t, loadAt, 10))
+
+	// changes after the checkpoint do not alter the value at the checkpoint
+	require.NoError(t, item.Save(store, newCoin(3), 12))
+	require.NoError(t, item.Save(store, newCoin(4), 12))
+	require.Equal(t, int64(2), amountAt(t, loadAt, 10))
+
+	item.AddCheckpoint(store, 12)
+	require.Equal(t, int64(4), amountAt(t, loadAt, 12))
+
+	// checkpoints are reference counted
+	item.AddCheckpoint(store, 12)
+	item.RemoveCheckpoint(store, 12)
+	require.Equal(t, int64(4), amountAt(t, loadAt, 12))
+	item.RemoveCheckpoint(store, 12)
+	_, err = item.LoadAt(store, 12, &types.Coin{})
+	require.Equal(t, NotCheckpointedErr{Height: 12}, err)
+}
+
+func TestSnapshotItem_SelectedUnrecordedChanges(t *testing.T) {
+	store := mock.Storage()
+	item := NewSnapshotItem("total", "total__checkpoints", "total__changelog", Selected)
+	loadAt := func(height uint64, value std.JSONType) (bool, error) {
+		return item.LoadAt(store, height, value)
+	}
+
+	item.AddCheckpoint(store, 10)
+	require.NoError(t, item.Save(store, newCoin(1), 10))
+	item.RemoveCheckpoint(store, 10)
+
+	// changed while no checkpoint exists
+	require.NoError(t, item.Save(store, newCoin(2), 15))
+
+	item.AddCheckpoint(store, 20)
+	require.NoError(t, item.Save(store, newCoin(3), 25))
+	require.Equal(t, int64(2), amountAt(t, loadAt, 20))
+}
+
+func TestSnapshotItem_Never(t *testing.T) {
+	store := mock.Storage()
+	item := NewSnapshotItem("total", "total__checkpoints", "total__changelog", Never)
+
+	require.NoError(t, item.Save(store, newCoin(1), 5))
+	item.AddCheckpoint(store, 5)
+	_, err := item.LoadAt(store, 5, &types.Coin{})
+	require.Equal(t, NotCheckpointedErr{Height: 5}, err)
+
+	// nothing was recorded
+	count, err := std.Count(store.Range(nil, nil, std.Ascending))
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), count) // value and checkpoint
+}
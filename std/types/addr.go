@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std/internal/addr"
+)
+
+// Addr is a human readable address which was validated by the chain,
+// it is the Go counterpart of cosmwasm_std::Addr.
+//
+// An Addr can only be obtained from the std.Api ValidateAddress and HumanAddress
+// functions, or decoded from data provided by the chain such as Env and MessageInfo.
+// Addresses received in user messages must be decoded as strings and validated,
+// as decoding an Addr from JSON does not perform any validation.
+// Tests can create addresses with mock.Addr.
+//
+// The String method returns the address.
+type Addr = addr.Addr
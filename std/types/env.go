@@ -22,15 +22,15 @@ type BlockInfo struct {
 }
 
 type MessageInfo struct {
-	// binary encoding of sdk.AccAddress executing the contract
-	Sender string `json:"sender"`
+	// bech32 encoding of sdk.AccAddress executing the contract
+	Sender Addr `json:"sender"`
 	// amount of funds send to the contract along with this message
 	Funds []Coin `json:"funds,emptyslice"`
 }
 
 type ContractInfo struct {
 	// bech32 encoding of sdk.AccAddress of the contract, to be used when sending messages
-	Address string
+	Address Addr
 }
 
 type TransactionInfo struct {
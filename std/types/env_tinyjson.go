@@ -101,7 +101,9 @@ func tinyjson2cc1611bDecodeGithubComCosmwasmCosmwasmGoStdTypes1(in *jlexer.Lexer
 		}
 		switch key {
 		case "sender":
-			out.Sender = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Sender).UnmarshalJSON(data))
+			}
 		case "funds":
 			if in.IsNull() {
 				in.Skip()
@@ -142,7 +144,7 @@ func tinyjson2cc1611bEncodeGithubComCosmwasmCosmwasmGoStdTypes1(out *jwriter.Wri
 	{
 		const prefix string = ",\"sender\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Sender))
+		out.Raw((in.Sender).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"funds\":"
@@ -292,7 +294,9 @@ func tinyjson2cc1611bDecodeGithubComCosmwasmCosmwasmGoStdTypes3(in *jlexer.Lexer
 		}
 		switch key {
 		case "address":
-			out.Address = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Address).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -310,7 +314,7 @@ func tinyjson2cc1611bEncodeGithubComCosmwasmCosmwasmGoStdTypes3(out *jwriter.Wri
 	{
 		const prefix string = ",\"address\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Address))
+		out.Raw((in.Address).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -74,7 +74,7 @@ func (m BankMsg) ToMsg() CosmosMsg {
 // SendMsg contains instructions for a Cosmos-SDK/SendMsg
 // It has a fixed interface here and should be converted into the proper SDK format before dispatching
 type SendMsg struct {
-	ToAddress string `json:"to_address"`
+	ToAddress Addr   `json:"to_address"`
 	Amount    []Coin `json:"amount,emptyslice"`
 }
 
@@ -187,7 +187,7 @@ func (m DistributionMsg) ToMsg() CosmosMsg {
 // `delegator_address` is automatically filled with the current contract's address.
 type SetWithdrawAddressMsg struct {
 	// Address contains the `delegator_address` of a MsgSetWithdrawAddress
-	Address string `json:"address"`
+	Address Addr `json:"address"`
 }
 
 func (m SetWithdrawAddressMsg) ToMsg() CosmosMsg {
@@ -248,7 +248,7 @@ func (m WasmMsg) ToMsg() CosmosMsg {
 type ExecuteMsg struct {
 	// ContractAddr is the sdk.AccAddress of the contract, which uniquely defines
 	// the contract ID and instance ID. The sdk module should maintain a reverse lookup table.
-	ContractAddr string `json:"contract_addr"`
+	ContractAddr Addr `json:"contract_addr"`
 	// Msg is assumed to be a json-encoded message, which will be passed directly
 	// as `userMsg` when calling `Handle` on the above-defined contract
 	Msg []byte `json:"msg,omitempty"`
@@ -273,7 +273,7 @@ type InstantiateMsg struct {
 	// Label is optional metadata to be stored with a contract instance.
 	Label string `json:"label"`
 	// Admin (optional) may be set here to allow future migrations from this address
-	Admin string `json:"admin,omitempty"`
+	Admin Addr `json:"admin,omitempty"`
 }
 
 func (m InstantiateMsg) ToMsg() CosmosMsg {
@@ -285,7 +285,7 @@ func (m InstantiateMsg) ToMsg() CosmosMsg {
 // listed as "admin" of the contract to be migrated.
 type MigrateMsg struct {
 	// ContractAddr is the sdk.AccAddress of the target contract, to migrate.
-	ContractAddr string `json:"contract_addr"`
+	ContractAddr Addr `json:"contract_addr"`
 	// NewCodeID is the reference to the wasm byte code for the new logic to migrate to
 	NewCodeID uint64 `json:"new_code_id"`
 	// Msg is assumed to be a json-encoded message, which will be passed directly
@@ -301,9 +301,9 @@ func (m MigrateMsg) ToMsg() CosmosMsg {
 // (https://github.com/CosmWasm/cosmwasm/blob/v0.14.0-beta5/packages/std/src/results/cosmos_msg.rs#L158-L160).
 type UpdateAdminMsg struct {
 	// ContractAddr is the sdk.AccAddress of the target contract.
-	ContractAddr string `json:"contract_addr"`
+	ContractAddr Addr `json:"contract_addr"`
 	// Admin is the sdk.AccAddress of the new admin.
-	Admin string `json:"admin"`
+	Admin Addr `json:"admin"`
 }
 
 func (m UpdateAdminMsg) ToMsg() CosmosMsg {
@@ -314,7 +314,7 @@ func (m UpdateAdminMsg) ToMsg() CosmosMsg {
 // (https://github.com/CosmWasm/cosmwasm/blob/v0.14.0-beta5/packages/std/src/results/cosmos_msg.rs#L158-L160).
 type ClearAdminMsg struct {
 	// ContractAddr is the sdk.AccAddress of the target contract.
-	ContractAddr string `json:"contract_addr"`
+	ContractAddr Addr `json:"contract_addr"`
 }
 
 func (m ClearAdminMsg) ToMsg() CosmosMsg {
@@ -329,9 +329,13 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes3(in *jlexer.Lexer
 		}
 		switch key {
 		case "contract_addr":
-			out.ContractAddr = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ContractAddr).UnmarshalJSON(data))
+			}
 		case "admin":
-			out.Admin = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Admin).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -349,12 +353,12 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes3(out *jwriter.Wri
 	{
 		const prefix string = ",\"contract_addr\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ContractAddr))
+		out.Raw((in.ContractAddr).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"admin\":"
 		out.RawString(prefix)
-		out.String(string(in.Admin))
+		out.Raw((in.Admin).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -668,7 +672,9 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes7(in *jlexer.Lexer
 		}
 		switch key {
 		case "address":
-			out.Address = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Address).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -686,7 +692,7 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes7(out *jwriter.Wri
 	{
 		const prefix string = ",\"address\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Address))
+		out.Raw((in.Address).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -734,7 +740,9 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes8(in *jlexer.Lexer
 		}
 		switch key {
 		case "to_address":
-			out.ToAddress = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ToAddress).UnmarshalJSON(data))
+			}
 		case "amount":
 			if in.IsNull() {
 				in.Skip()
@@ -775,7 +783,7 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes8(out *jwriter.Wri
 	{
 		const prefix string = ",\"to_address\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ToAddress))
+		out.Raw((in.ToAddress).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"amount\":"
@@ -1099,7 +1107,9 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes11(in *jlexer.Lexe
 		}
 		switch key {
 		case "contract_addr":
-			out.ContractAddr = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ContractAddr).UnmarshalJSON(data))
+			}
 		case "new_code_id":
 			out.NewCodeID = uint64(in.Uint64())
 		case "msg":
@@ -1126,7 +1136,7 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes11(out *jwriter.Wr
 	{
 		const prefix string = ",\"contract_addr\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ContractAddr))
+		out.Raw((in.ContractAddr).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"new_code_id\":"
@@ -1218,7 +1228,9 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes12(in *jlexer.Lexe
 		case "label":
 			out.Label = string(in.String())
 		case "admin":
-			out.Admin = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Admin).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1262,10 +1274,10 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes12(out *jwriter.Wr
 		out.RawString(prefix)
 		out.String(string(in.Label))
 	}
-	if in.Admin != "" {
+	if (in.Admin).IsDefined() {
 		const prefix string = ",\"admin\":"
 		out.RawString(prefix)
-		out.String(string(in.Admin))
+		out.Raw((in.Admin).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -1388,7 +1400,9 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes14(in *jlexer.Lexe
 		}
 		switch key {
 		case "contract_addr":
-			out.ContractAddr = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ContractAddr).UnmarshalJSON(data))
+			}
 		case "msg":
 			if in.IsNull() {
 				in.Skip()
@@ -1436,7 +1450,7 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes14(out *jwriter.Wr
 	{
 		const prefix string = ",\"contract_addr\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ContractAddr))
+		out.Raw((in.ContractAddr).MarshalJSON())
 	}
 	if len(in.Msg) != 0 {
 		const prefix string = ",\"msg\":"
@@ -2040,7 +2054,9 @@ func tinyjsonF5cd6cf9DecodeGithubComCosmwasmCosmwasmGoStdTypes20(in *jlexer.Lexe
 		}
 		switch key {
 		case "contract_addr":
-			out.ContractAddr = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ContractAddr).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2058,7 +2074,7 @@ func tinyjsonF5cd6cf9EncodeGithubComCosmwasmCosmwasmGoStdTypes20(out *jwriter.Wr
 	{
 		const prefix string = ",\"contract_addr\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ContractAddr))
+		out.Raw((in.ContractAddr).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -233,6 +233,10 @@ func (m StargateQuery) ToQuery() QueryRequest {
 
 // This is the protobuf response, binary encoded.
 // The caller is responsible for knowing how to parse.
+//
+// Deprecated: the chain returns the protobuf response of a StargateQuery as is,
+// not wrapped in this JSON object. Use the result of Querier.RawQuery directly,
+// as stargate.Query does.
 type StargateResponse struct {
 	Response []byte `json:"response"`
 }
@@ -243,12 +247,14 @@ var (
 	_ ToQuery = SmartQuery{}
 	_ ToQuery = RawQuery{}
 	_ ToQuery = ContractInfoQuery{}
+	_ ToQuery = CodeInfoQuery{}
 )
 
 type WasmQuery struct {
 	Smart        *SmartQuery        `json:"smart,omitempty"`
 	Raw          *RawQuery          `json:"raw,omitempty"`
 	ContractInfo *ContractInfoQuery `json:"contract_info,omitempty"`
+	CodeInfo     *CodeInfoQuery     `json:"code_info,omitempty"`
 }
 
 func (m WasmQuery) ToQuery() QueryRequest {
@@ -265,7 +271,8 @@ func (m SmartQuery) ToQuery() QueryRequest {
 	return QueryRequest{Wasm: &WasmQuery{Smart: &m}}
 }
 
-// RawQuery response is raw bytes ([]byte)
+// RawQuery response is the raw bytes ([]byte) stored under Key,
+// empty if there are none. The response is not JSON encoded.
 type RawQuery struct {
 	ContractAddr string `json:"contract_addr"`
 	Key          []byte `json:"key"`
@@ -284,12 +291,31 @@ func (m ContractInfoQuery) ToQuery() QueryRequest {
 	return QueryRequest{Wasm: &WasmQuery{ContractInfo: &m}}
 }
 
+// ContractInfoResponse is the response to a ContractInfoQuery.
 type ContractInfoResponse struct {
 	CodeID  uint64 `json:"code_id"`
-	Creator string `json:"creator"`
+	Creator Addr   `json:"creator"`
 	// Set to the admin who can migrate contract, if any
-	Admin  string `json:"admin,omit_empty"`
-	Pinned bool   `json:"pinned"`
+	Admin  Addr `json:"admin,omitempty"`
+	Pinned bool `json:"pinned"`
 	// Set if the contract is IBC enabled
-	IBCPort string `json:"ibc_port,omit_empty"`
+	IBCPort string `json:"ibc_port,omitempty"`
+}
+
+// CodeInfoQuery returns a CodeInfoResponse, it is only supported
+// by chains running CosmWasm 1.2 or later.
+type CodeInfoQuery struct {
+	CodeID uint64 `json:"code_id"`
+}
+
+func (m CodeInfoQuery) ToQuery() QueryRequest {
+	return QueryRequest{Wasm: &WasmQuery{CodeInfo: &m}}
+}
+
+// CodeInfoResponse is the response to a CodeInfoQuery.
+type CodeInfoResponse struct {
+	CodeID  uint64 `json:"code_id"`
+	Creator Addr   `json:"creator"`
+	// Checksum is the hex encoded SHA-256 hash of the wasm code
+	Checksum string `json:"checksum"`
 }
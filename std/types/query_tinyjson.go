@@ -64,6 +64,16 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes(in *jlexer.Lexer,
 				}
 				(*out.ContractInfo).UnmarshalTinyJSON(in)
 			}
+		case "code_info":
+			if in.IsNull() {
+				in.Skip()
+				out.CodeInfo = nil
+			} else {
+				if out.CodeInfo == nil {
+					out.CodeInfo = new(CodeInfoQuery)
+				}
+				(*out.CodeInfo).UnmarshalTinyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -104,6 +114,16 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes(out *jwriter.Writ
 		}
 		(*in.ContractInfo).MarshalTinyJSON(out)
 	}
+	if in.CodeInfo != nil {
+		const prefix string = ",\"code_info\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.CodeInfo).MarshalTinyJSON(out)
+	}
 	out.RawByte('}')
 }
 
@@ -1543,9 +1563,13 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes16(in *jlexer.Lexe
 		case "code_id":
 			out.CodeID = uint64(in.Uint64())
 		case "creator":
-			out.Creator = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Creator).UnmarshalJSON(data))
+			}
 		case "admin":
-			out.Admin = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Admin).UnmarshalJSON(data))
+			}
 		case "pinned":
 			out.Pinned = bool(in.Bool())
 		case "ibc_port":
@@ -1572,19 +1596,19 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes16(out *jwriter.Wr
 	{
 		const prefix string = ",\"creator\":"
 		out.RawString(prefix)
-		out.String(string(in.Creator))
+		out.Raw((in.Creator).MarshalJSON())
 	}
-	{
+	if (in.Admin).IsDefined() {
 		const prefix string = ",\"admin\":"
 		out.RawString(prefix)
-		out.String(string(in.Admin))
+		out.Raw((in.Admin).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"pinned\":"
 		out.RawString(prefix)
 		out.Bool(bool(in.Pinned))
 	}
-	{
+	if in.IBCPort != "" {
 		const prefix string = ",\"ibc_port\":"
 		out.RawString(prefix)
 		out.String(string(in.IBCPort))
@@ -1681,7 +1705,155 @@ func (v *ContractInfoQuery) UnmarshalJSON(data []byte) error {
 func (v *ContractInfoQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes17(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(in *jlexer.Lexer, out *BondedDenomResponse) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(in *jlexer.Lexer, out *CodeInfoResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code_id":
+			out.CodeID = uint64(in.Uint64())
+		case "creator":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Creator).UnmarshalJSON(data))
+			}
+		case "checksum":
+			out.Checksum = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(out *jwriter.Writer, in CodeInfoResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CodeID))
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		out.Raw((in.Creator).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"checksum\":"
+		out.RawString(prefix)
+		out.String(string(in.Checksum))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CodeInfoResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v CodeInfoResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CodeInfoResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *CodeInfoResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(l, v)
+}
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(in *jlexer.Lexer, out *CodeInfoQuery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code_id":
+			out.CodeID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(out *jwriter.Writer, in CodeInfoQuery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CodeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CodeInfoQuery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v CodeInfoQuery) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CodeInfoQuery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *CodeInfoQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(l, v)
+}
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(in *jlexer.Lexer, out *BondedDenomResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1712,7 +1884,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(out *jwriter.Writer, in BondedDenomResponse) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(out *jwriter.Writer, in BondedDenomResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1727,27 +1899,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v BondedDenomResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v BondedDenomResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes18(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BondedDenomResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *BondedDenomResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes18(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(in *jlexer.Lexer, out *BondedDenomQuery) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(in *jlexer.Lexer, out *BondedDenomQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1776,7 +1948,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(out *jwriter.Writer, in BondedDenomQuery) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(out *jwriter.Writer, in BondedDenomQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1786,27 +1958,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v BondedDenomQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v BondedDenomQuery) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes19(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BondedDenomQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *BondedDenomQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes19(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(in *jlexer.Lexer, out *BankQuery) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(in *jlexer.Lexer, out *BankQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1855,7 +2027,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(out *jwriter.Writer, in BankQuery) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(out *jwriter.Writer, in BankQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1881,27 +2053,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v BankQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v BankQuery) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes20(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BankQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *BankQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes20(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(in *jlexer.Lexer, out *BalanceResponse) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(in *jlexer.Lexer, out *BalanceResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1932,7 +2104,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(out *jwriter.Writer, in BalanceResponse) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(out *jwriter.Writer, in BalanceResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1947,27 +2119,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v BalanceResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v BalanceResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes21(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BalanceResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *BalanceResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes21(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(in *jlexer.Lexer, out *BalanceQuery) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(in *jlexer.Lexer, out *BalanceQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2000,7 +2172,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(out *jwriter.Writer, in BalanceQuery) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(out *jwriter.Writer, in BalanceQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2020,27 +2192,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v BalanceQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v BalanceQuery) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes22(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BalanceQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *BalanceQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes22(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(in *jlexer.Lexer, out *AllValidatorsResponse) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(in *jlexer.Lexer, out *AllValidatorsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2092,7 +2264,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(out *jwriter.Writer, in AllValidatorsResponse) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(out *jwriter.Writer, in AllValidatorsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2116,27 +2288,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v AllValidatorsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllValidatorsResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes23(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllValidatorsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllValidatorsResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes23(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(in *jlexer.Lexer, out *AllValidatorsQuery) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(in *jlexer.Lexer, out *AllValidatorsQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2165,7 +2337,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(out *jwriter.Writer, in AllValidatorsQuery) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(out *jwriter.Writer, in AllValidatorsQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2175,27 +2347,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v AllValidatorsQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllValidatorsQuery) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes24(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllValidatorsQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllValidatorsQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes24(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(in *jlexer.Lexer, out *AllDelegationsResponse) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(in *jlexer.Lexer, out *AllDelegationsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2247,7 +2419,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(out *jwriter.Writer, in AllDelegationsResponse) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(out *jwriter.Writer, in AllDelegationsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2271,27 +2443,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v AllDelegationsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllDelegationsResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes25(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllDelegationsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllDelegationsResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes25(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(in *jlexer.Lexer, out *AllDelegationsQuery) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(in *jlexer.Lexer, out *AllDelegationsQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2322,7 +2494,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(out *jwriter.Writer, in AllDelegationsQuery) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(out *jwriter.Writer, in AllDelegationsQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2337,27 +2509,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v AllDelegationsQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllDelegationsQuery) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes26(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllDelegationsQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllDelegationsQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes26(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(in *jlexer.Lexer, out *AllBalancesResponse) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes29(in *jlexer.Lexer, out *AllBalancesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2409,7 +2581,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(out *jwriter.Writer, in AllBalancesResponse) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes29(out *jwriter.Writer, in AllBalancesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2433,27 +2605,27 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v AllBalancesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllBalancesResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes27(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllBalancesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllBalancesResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes27(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes29(l, v)
 }
-func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(in *jlexer.Lexer, out *AllBalancesQuery) {
+func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes30(in *jlexer.Lexer, out *AllBalancesQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2484,7 +2656,7 @@ func tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(out *jwriter.Writer, in AllBalancesQuery) {
+func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes30(out *jwriter.Writer, in AllBalancesQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2499,23 +2671,23 @@ func tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v AllBalancesQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(&w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllBalancesQuery) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes28(w, v)
+	tinyjsonAa6e548eEncodeGithubComCosmwasmCosmwasmGoStdTypes30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllBalancesQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(&r, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllBalancesQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes28(l, v)
+	tinyjsonAa6e548eDecodeGithubComCosmwasmCosmwasmGoStdTypes30(l, v)
 }
@@ -0,0 +1,129 @@
+package systest
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	unitmocks "github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+
+	mocks "github.com/CosmWasm/wasmvm/api"
+)
+
+// rawMsg is a query message sent to the fixture contracts as is.
+type rawMsg []byte
+
+func (m rawMsg) MarshalJSON() ([]byte, error) {
+	return m, nil
+}
+
+// recoverErrors are the errors ExternalApi.RecoverSecp256k1PubKey returns for the error codes of the VM.
+var recoverErrors = map[uint8]string{
+	3:  "invalid hash format",
+	4:  "invalid signature format",
+	6:  "invalid recovery param",
+	10: "generic error",
+}
+
+// TestRecoverSecp256k1PubKey checks that the mock Api recovers the same public keys and
+// returns the same errors as the secp256k1_recover_pubkey import of the VM.
+func TestRecoverSecp256k1PubKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recover.wasm")
+	require.NoError(t, os.WriteFile(path, RecoverPubKeyContract(), 0o644))
+	instance := NewInstance(t, path, 15_000_000_000_000, nil)
+
+	// vmRecover calls the import of the VM through the fixture contract.
+	// Errors aborting the contract are returned as is.
+	vmRecover := func(t *testing.T, hash, signature []byte, param uint32) ([]byte, error) {
+		msg := append([]byte{byte(len(hash))}, hash...)
+		msg = append(msg, byte(len(signature)))
+		msg = append(msg, signature...)
+		paramBytes := make([]byte, 4)
+		binary.LittleEndian.PutUint32(paramBytes, param)
+		msg = append(msg, paramBytes...)
+		_, _, err := instance.Query(mocks.MockEnv(), rawMsg(msg))
+		require.Error(t, err)
+		result, decodeErr := hex.DecodeString(err.Error())
+		if decodeErr != nil {
+			return nil, err
+		}
+		if code := result[0]; code != 0 {
+			msg, ok := recoverErrors[code]
+			if !ok {
+				msg = "unknown error code (" + strconv.Itoa(int(code)) + ")"
+			}
+			return nil, types.GenericError("secp256k1_recover_pubkey errored: " + msg)
+		}
+		return result[1:], nil
+	}
+
+	seed := sha256.Sum256([]byte("cosmwasm-go"))
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), seed[:])
+	hash := sha256.Sum256([]byte("message"))
+	compact, err := secp256k1.SignCompact(secp256k1.S256(), privKey, hash[:], false)
+	require.NoError(t, err)
+	signature := compact[1:]
+	param := uint32(compact[0] - 27)
+
+	n := secp256k1.S256().N.Bytes()
+	withR := func(r []byte) []byte { return append(append([]byte{}, r...), signature[32:]...) }
+	withS := func(s []byte) []byte { return append(append([]byte{}, signature[:32]...), s...) }
+	zero := make([]byte, 32)
+
+	specs := map[string]struct {
+		hash      []byte
+		signature []byte
+		param     uint32
+		valid     bool
+		// abort is set when the VM aborts the contract rather than returning an error code
+		abort string
+	}{
+		"valid":                     {hash: hash[:], signature: signature, param: param, valid: true},
+		"other recovery param":      {hash: hash[:], signature: signature, param: 1 - param, valid: true},
+		"other hash":                {hash: zero, signature: signature, param: param, valid: true},
+		"short hash":                {hash: hash[:31], signature: signature, param: param},
+		"long hash":                 {hash: append(hash[:], 0), signature: signature, param: param, abort: "Region length too big"},
+		"short signature":           {hash: hash[:], signature: signature[:63], param: param},
+		"long signature":            {hash: hash[:], signature: append(append([]byte{}, signature...), 0), param: param, abort: "Region length too big"},
+		"invalid recovery param":    {hash: hash[:], signature: signature, param: 2},
+		"zero r":                    {hash: hash[:], signature: withR(zero), param: param},
+		"zero s":                    {hash: hash[:], signature: withS(zero), param: param},
+		"r equal to curve order":    {hash: hash[:], signature: withR(n), param: param},
+		"s equal to curve order":    {hash: hash[:], signature: withS(n), param: param},
+		"invalid hash and recovery": {hash: hash[:31], signature: signature, param: 2},
+	}
+	api := unitmocks.API()
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			vmKey, vmErr := vmRecover(t, spec.hash, spec.signature, spec.param)
+			mockKey, mockErr := api.RecoverSecp256k1PubKey(spec.hash, spec.signature, std.Secp256k1RecoveryParam(spec.param))
+			if spec.abort != "" {
+				require.ErrorContains(t, vmErr, spec.abort)
+				require.Error(t, mockErr)
+				require.Nil(t, mockKey)
+				return
+			}
+			require.Equal(t, vmErr, mockErr)
+			require.Equal(t, vmKey, mockKey)
+			if spec.valid {
+				require.NoError(t, vmErr)
+				require.Len(t, vmKey, 65)
+			} else {
+				require.Error(t, vmErr)
+			}
+		})
+	}
+	// the key recovered from the signature is the signer's
+	key, err := vmRecover(t, hash[:], signature, param)
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey().SerializeUncompressed(), key)
+}
@@ -0,0 +1,269 @@
+package systest
+
+import (
+	"encoding/binary"
+)
+
+// RecoverPubKeyContract returns a minimal contract, assembled by hand as TinyGo
+// contracts only call the crypto imports with their own arguments.
+// Its query entry point calls secp256k1_recover_pubkey with the arguments encoded
+// in the query message, so that std/mock can be compared with the VM:
+//
+//	hash length (1 byte) || hash || signature length (1 byte) || signature || recovery param (4 bytes LE)
+//
+// The query fails with the hex encoded result as error message: the error code
+// returned by the VM (1 byte), followed by the recovered key if the code is 0.
+func RecoverPubKeyContract() []byte {
+	const (
+		tableAddr = 0  // hex digits
+		hashAddr  = 16 // Region of the hash
+		sigAddr   = 32 // Region of the signature
+		codeAddr  = 48 // error code byte
+		heapStart = 1024
+	)
+	const (
+		fnRecover = iota // imported
+		fnAllocate
+		fnDeallocate
+		fnInterfaceVersion
+		fnQuery
+		fnHexWrite
+		fnInstantiate
+	)
+	const (
+		globalHeap = iota
+		globalOut
+	)
+	prefix := []byte(`{"error":"`)
+
+	m := wasmModule{}
+	// types: allocate, deallocate, interface_version_8, query, secp256k1_recover_pubkey, hexWrite, instantiate
+	m.section(1, vec(
+		funcType([]byte{i32}, []byte{i32}),
+		funcType([]byte{i32}, nil),
+		funcType(nil, nil),
+		funcType([]byte{i32, i32}, []byte{i32}),
+		funcType([]byte{i32, i32, i32}, []byte{i64}),
+		funcType([]byte{i32, i32}, nil),
+		funcType([]byte{i32, i32, i32}, []byte{i32}),
+	))
+	m.section(2, vec(cat(name("env"), name("secp256k1_recover_pubkey"), []byte{0x00}, uleb(4))))
+	m.section(3, vec(uleb(0), uleb(1), uleb(2), uleb(3), uleb(5), uleb(6)))
+	// one memory of 2 pages
+	m.section(5, vec([]byte{0x00, 0x02}))
+	m.section(6, vec(
+		cat([]byte{i32, 0x01}, op(i32Const, sleb(heapStart)), []byte{end}),
+		cat([]byte{i32, 0x01}, op(i32Const, sleb(0)), []byte{end}),
+	))
+	m.section(7, vec(
+		cat(name("memory"), []byte{0x02}, uleb(0)),
+		cat(name("allocate"), []byte{0x00}, uleb(fnAllocate)),
+		cat(name("deallocate"), []byte{0x00}, uleb(fnDeallocate)),
+		cat(name("interface_version_8"), []byte{0x00}, uleb(fnInterfaceVersion)),
+		cat(name("query"), []byte{0x00}, uleb(fnQuery)),
+		cat(name("instantiate"), []byte{0x00}, uleb(fnInstantiate)),
+	))
+
+	// allocate(size) returns a Region of capacity size, bumping the heap.
+	// The VM only reads Regions aligned on 4 bytes.
+	allocate := funcBody([]local{{1, i32}},
+		op(globalGet, uleb(globalHeap)), op(i32Const, sleb(3)), op(i32Add), op(i32Const, sleb(-4)), op(i32And),
+		op(localTee, uleb(1)), op(globalSet, uleb(globalHeap)),
+		// region.offset = region + 12
+		op(localGet, uleb(1)), op(globalGet, uleb(globalHeap)), op(i32Const, sleb(12)), op(i32Add), store32(0),
+		// region.capacity = size, region.length = 0
+		op(localGet, uleb(1)), op(localGet, uleb(0)), store32(4),
+		op(localGet, uleb(1)), op(i32Const, sleb(0)), store32(8),
+		// heap += 12 + size
+		op(globalGet, uleb(globalHeap)), op(i32Const, sleb(12)), op(i32Add), op(localGet, uleb(0)), op(i32Add),
+		op(globalSet, uleb(globalHeap)),
+		op(localGet, uleb(1)),
+	)
+	deallocate := funcBody(nil)
+	interfaceVersion := funcBody(nil)
+	// instantiate is required by the VM, but the fixture is only queried
+	instantiate := funcBody(nil, []byte{unreachable})
+
+	// hexWrite(src, len) writes the hex encoding of the bytes at src to the output.
+	hexWrite := funcBody([]local{{1, i32}},
+		op(localGet, uleb(0)), op(localGet, uleb(1)), op(i32Add), op(localSet, uleb(2)),
+		[]byte{block, void, loop, void},
+		op(localGet, uleb(0)), op(localGet, uleb(2)), op(i32GeU), op(brIf, uleb(1)),
+		op(globalGet, uleb(globalOut)),
+		op(localGet, uleb(0)), load8(0), op(i32Const, sleb(4)), op(i32ShrU), load8(tableAddr),
+		store8(0),
+		op(globalGet, uleb(globalOut)),
+		op(localGet, uleb(0)), load8(0), op(i32Const, sleb(15)), op(i32And), load8(tableAddr),
+		store8(1),
+		op(globalGet, uleb(globalOut)), op(i32Const, sleb(2)), op(i32Add), op(globalSet, uleb(globalOut)),
+		op(localGet, uleb(0)), op(i32Const, sleb(1)), op(i32Add), op(localSet, uleb(0)),
+		op(br, uleb(0)),
+		[]byte{end, end},
+	)
+
+	// query(env, msg), locals: 2 msg data, 3 hash length, 4 signature length,
+	// 5 output start, 6 scratch, 7 recover result
+	query := funcBody([]local{{5, i32}, {1, i64}},
+		op(localGet, uleb(1)), load32(0), op(localSet, uleb(2)),
+		op(localGet, uleb(2)), load8(0), op(localSet, uleb(3)),
+		// hash Region
+		op(i32Const, sleb(hashAddr)), op(localGet, uleb(2)), op(i32Const, sleb(1)), op(i32Add), store32(0),
+		op(i32Const, sleb(hashAddr)), op(localGet, uleb(3)), store32(4),
+		op(i32Const, sleb(hashAddr)), op(localGet, uleb(3)), store32(8),
+		// signature Region
+		op(localGet, uleb(2)), op(localGet, uleb(3)), op(i32Add), load8(1), op(localSet, uleb(4)),
+		op(i32Const, sleb(sigAddr)), op(localGet, uleb(2)), op(localGet, uleb(3)), op(i32Add), op(i32Const, sleb(2)), op(i32Add), store32(0),
+		op(i32Const, sleb(sigAddr)), op(localGet, uleb(4)), store32(4),
+		op(i32Const, sleb(sigAddr)), op(localGet, uleb(4)), store32(8),
+		// secp256k1_recover_pubkey(hash, signature, param)
+		op(i32Const, sleb(hashAddr)), op(i32Const, sleb(sigAddr)),
+		op(localGet, uleb(2)), op(localGet, uleb(3)), op(i32Add), op(localGet, uleb(4)), op(i32Add), load32(2),
+		op(call, uleb(fnRecover)), op(localSet, uleb(7)),
+		// the output starts on the heap
+		op(globalGet, uleb(globalHeap)), op(localTee, uleb(5)), op(globalSet, uleb(globalOut)),
+		op(globalGet, uleb(globalOut)), op(i64Const, sleb(int64(binary.LittleEndian.Uint64(prefix[:8])))), store64(0),
+		op(globalGet, uleb(globalOut)), op(i32Const, sleb(int64(binary.LittleEndian.Uint16(prefix[8:])))), store16(8),
+		op(globalGet, uleb(globalOut)), op(i32Const, sleb(int64(len(prefix)))), op(i32Add), op(globalSet, uleb(globalOut)),
+		// error code
+		op(i32Const, sleb(codeAddr)), op(localGet, uleb(7)), op(i64Const, sleb(32)), op(i64ShrU), op(i32WrapI64), store8(0),
+		op(i32Const, sleb(codeAddr)), op(i32Const, sleb(1)), op(call, uleb(fnHexWrite)),
+		// recovered key
+		op(i32Const, sleb(codeAddr)), load8(0), op(i32Eqz),
+		[]byte{ifOp, void},
+		op(localGet, uleb(7)), op(i32WrapI64), op(localTee, uleb(6)), load32(0), op(localGet, uleb(6)), load32(8),
+		op(call, uleb(fnHexWrite)),
+		[]byte{end},
+		op(globalGet, uleb(globalOut)), op(i32Const, sleb(int64(binary.LittleEndian.Uint16([]byte(`"}`))))), store16(0),
+		op(globalGet, uleb(globalOut)), op(i32Const, sleb(2)), op(i32Add), op(globalSet, uleb(globalOut)),
+		op(globalGet, uleb(globalOut)), op(globalSet, uleb(globalHeap)),
+		// result Region
+		op(i32Const, sleb(0)), op(call, uleb(fnAllocate)), op(localTee, uleb(6)), op(localGet, uleb(5)), store32(0),
+		op(localGet, uleb(6)), op(globalGet, uleb(globalOut)), op(localGet, uleb(5)), op(i32Sub), store32(4),
+		op(localGet, uleb(6)), op(globalGet, uleb(globalOut)), op(localGet, uleb(5)), op(i32Sub), store32(8),
+		op(localGet, uleb(6)),
+	)
+	m.section(10, vec(allocate, deallocate, interfaceVersion, query, hexWrite, instantiate))
+	m.section(11, vec(cat([]byte{0x00}, op(i32Const, sleb(tableAddr)), []byte{end}, name("0123456789abcdef"))))
+	return m.bytes()
+}
+
+// The helpers below encode the subset of the WebAssembly binary format used by the fixtures.
+
+const (
+	i32 = 0x7f
+	i64 = 0x7e
+
+	void        = 0x40
+	unreachable = 0x00
+	block       = 0x02
+	loop        = 0x03
+	ifOp        = 0x04
+	end         = 0x0b
+	br          = 0x0c
+	brIf        = 0x0d
+	call        = 0x10
+	localGet    = 0x20
+	localSet    = 0x21
+	localTee    = 0x22
+	globalGet   = 0x23
+	globalSet   = 0x24
+
+	i32Const   = 0x41
+	i64Const   = 0x42
+	i32Eqz     = 0x45
+	i32GeU     = 0x4f
+	i32Add     = 0x6a
+	i32Sub     = 0x6b
+	i32And     = 0x71
+	i32ShrU    = 0x76
+	i64ShrU    = 0x88
+	i32WrapI64 = 0xa7
+)
+
+type wasmModule struct {
+	buf []byte
+}
+
+func (m *wasmModule) section(id byte, content []byte) {
+	m.buf = append(m.buf, id)
+	m.buf = append(m.buf, uleb(uint64(len(content)))...)
+	m.buf = append(m.buf, content...)
+}
+
+func (m *wasmModule) bytes() []byte {
+	return cat([]byte("\x00asm\x01\x00\x00\x00"), m.buf)
+}
+
+type local struct {
+	count uint64
+	typ   byte
+}
+
+func funcBody(locals []local, code ...[]byte) []byte {
+	body := uleb(uint64(len(locals)))
+	for _, l := range locals {
+		body = append(body, uleb(l.count)...)
+		body = append(body, l.typ)
+	}
+	body = append(cat(body, cat(code...)), end)
+	return cat(uleb(uint64(len(body))), body)
+}
+
+func funcType(params, results []byte) []byte {
+	return cat([]byte{0x60}, uleb(uint64(len(params))), params, uleb(uint64(len(results))), results)
+}
+
+func vec(items ...[]byte) []byte {
+	return cat(uleb(uint64(len(items))), cat(items...))
+}
+
+func name(s string) []byte {
+	return cat(uleb(uint64(len(s))), []byte(s))
+}
+
+func op(code byte, immediates ...[]byte) []byte {
+	return cat([]byte{code}, cat(immediates...))
+}
+
+// memory instructions with their alignment and offset immediates
+func load32(offset uint64) []byte  { return cat([]byte{0x28, 2}, uleb(offset)) }
+func load8(offset uint64) []byte   { return cat([]byte{0x2d, 0}, uleb(offset)) }
+func store32(offset uint64) []byte { return cat([]byte{0x36, 2}, uleb(offset)) }
+func store64(offset uint64) []byte { return cat([]byte{0x37, 3}, uleb(offset)) }
+func store8(offset uint64) []byte  { return cat([]byte{0x3a, 0}, uleb(offset)) }
+func store16(offset uint64) []byte { return cat([]byte{0x3b, 1}, uleb(offset)) }
+
+func uleb(v uint64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			c |= 0x80
+		}
+		b = append(b, c)
+		if v == 0 {
+			return b
+		}
+	}
+}
+
+func sleb(v int64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func cat(parts ...[]byte) []byte {
+	var b []byte
+	for _, p := range parts {
+		b = append(b, p...)
+	}
+	return b
+}
@@ -39,7 +39,7 @@ func NewInstance(t *testing.T, contractPath string, gasLimit uint64, funds []typ
 	mockApi := mocks.NewMockAPI()
 	mockApi.HumanAddress = func(canon []byte) (string, uint64, error) {
 		human, err := unitmocks.API().HumanAddress(canon)
-		return human, 5000, err
+		return human.String(), 5000, err
 	}
 	mockApi.CanonicalAddress = func(human string) ([]byte, uint64, error) {
 		canon, err := unitmocks.API().CanonicalAddress(human)
@@ -53,7 +53,10 @@ func NewInstance(t *testing.T, contractPath string, gasLimit uint64, funds []typ
 		GasMeter: gasMeter,
 		Store:    mocks.NewLookup(gasMeter),
 		Api:      mockApi,
-		Querier:  mocks.DefaultQuerier(mocks.MOCK_CONTRACT_ADDR, funds),
+		Querier: Querier{
+			MockQuerier: mocks.DefaultQuerier(mocks.MOCK_CONTRACT_ADDR, funds).(mocks.MockQuerier),
+			Staking:     &StakingQuerier{},
+		},
 	}
 }
 
@@ -64,12 +67,20 @@ type Instance struct {
 	GasMeter wasmvm.GasMeter
 	Store    *mocks.Lookup
 	Api      *mocks.GoAPI
-	Querier  mocks.Querier
+	Querier  Querier
 }
 
 func (i *Instance) SetQuerierBalance(addr string, balance []types.Coin) {
-	mq := i.Querier.(mocks.MockQuerier)
-	mq.Bank.Balances[addr] = balance
+	i.Querier.Bank.Balances[addr] = balance
+}
+
+// SetQuerierStaking replaces the state answering the staking queries of the contract.
+func (i *Instance) SetQuerierStaking(bondedDenom string, validators []types.Validator, delegations []types.FullDelegation) {
+	*i.Querier.Staking = StakingQuerier{
+		BondedDenom: bondedDenom,
+		Validators:  validators,
+		Delegations: delegations,
+	}
 }
 
 func (i *Instance) Instantiate(env types.Env, info types.MessageInfo, initMsg json.Marshaler) (*types.Response, uint64, error) {
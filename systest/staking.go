@@ -0,0 +1,94 @@
+package systest
+
+import (
+	"encoding/json"
+
+	mocks "github.com/CosmWasm/wasmvm/api"
+	types "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// StakingQuerier answers staking queries using the wasmvm types,
+// as the wasmvm MockQuerier does not support them.
+type StakingQuerier struct {
+	BondedDenom string
+	Validators  []types.Validator
+	Delegations []types.FullDelegation
+}
+
+func (q StakingQuerier) Query(request *types.StakingQuery) ([]byte, error) {
+	switch {
+	case request.BondedDenom != nil:
+		return json.Marshal(types.BondedDenomResponse{Denom: q.BondedDenom})
+	case request.AllValidators != nil:
+		return json.Marshal(types.AllValidatorsResponse{Validators: q.Validators})
+	case request.Validator != nil:
+		resp := types.ValidatorResponse{}
+		for i := range q.Validators {
+			if q.Validators[i].Address == request.Validator.Address {
+				resp.Validator = &q.Validators[i]
+				break
+			}
+		}
+		return json.Marshal(resp)
+	case request.AllDelegations != nil:
+		resp := types.AllDelegationsResponse{}
+		for _, d := range q.Delegations {
+			if d.Delegator == request.AllDelegations.Delegator {
+				resp.Delegations = append(resp.Delegations, types.Delegation{
+					Delegator: d.Delegator,
+					Validator: d.Validator,
+					Amount:    d.Amount,
+				})
+			}
+		}
+		return json.Marshal(resp)
+	case request.Delegation != nil:
+		resp := types.DelegationResponse{}
+		for i := range q.Delegations {
+			d := &q.Delegations[i]
+			if d.Delegator == request.Delegation.Delegator && d.Validator == request.Delegation.Validator {
+				resp.Delegation = d
+				break
+			}
+		}
+		return json.Marshal(resp)
+	default:
+		return nil, types.UnsupportedRequest{Kind: "Empty StakingQuery"}
+	}
+}
+
+// Querier extends the wasmvm MockQuerier with staking queries.
+type Querier struct {
+	mocks.MockQuerier
+	Staking *StakingQuerier
+}
+
+var _ types.Querier = Querier{}
+
+func (q Querier) Query(request types.QueryRequest, gasLimit uint64) ([]byte, error) {
+	if request.Staking != nil {
+		return q.Staking.Query(request.Staking)
+	}
+	return q.MockQuerier.Query(request, gasLimit)
+}
+
+// StdQuerier exposes a wasmvm Querier as a std.Querier, so that the std query
+// helpers can be checked against the types used by the VM.
+func StdQuerier(q types.Querier) std.Querier {
+	return stdQuerier{querier: q}
+}
+
+type stdQuerier struct {
+	querier types.Querier
+}
+
+func (q stdQuerier) RawQuery(raw []byte) ([]byte, error) {
+	var request types.QueryRequest
+	err := json.Unmarshal(raw, &request)
+	if err != nil {
+		return nil, err
+	}
+	return q.querier.Query(request, mocks.DEFAULT_QUERIER_GAS_LIMIT)
+}
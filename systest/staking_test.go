@@ -0,0 +1,72 @@
+package systest
+
+import (
+	"testing"
+
+	mocks "github.com/CosmWasm/wasmvm/api"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// TestStakingQueries checks that the requests and responses of the std staking
+// query helpers are JSON compatible with the wasmvm types. The hackatom integration
+// tests run the same queries through the VM.
+func TestStakingQueries(t *testing.T) {
+	vmValidator := wasmvmtypes.Validator{Address: "validator1", Commission: "0.05", MaxCommission: "0.1", MaxChangeRate: "0.01"}
+	vmDelegation := wasmvmtypes.FullDelegation{
+		Delegator:          "alice",
+		Validator:          "validator1",
+		Amount:             wasmvmtypes.NewCoin(100, "ustake"),
+		AccumulatedRewards: wasmvmtypes.Coins{wasmvmtypes.NewCoin(5, "ustake")},
+		CanRedelegate:      wasmvmtypes.NewCoin(80, "ustake"),
+	}
+	querier := std.QuerierWrapper{Querier: StdQuerier(Querier{
+		MockQuerier: mocks.DefaultQuerier(mocks.MOCK_CONTRACT_ADDR, nil).(mocks.MockQuerier),
+		Staking: &StakingQuerier{
+			BondedDenom: "ustake",
+			Validators:  []wasmvmtypes.Validator{vmValidator},
+			Delegations: []wasmvmtypes.FullDelegation{vmDelegation},
+		},
+	})}
+
+	validator := types.Validator{Address: "validator1", Commission: "0.05", MaxCommission: "0.1", MaxChangeRate: "0.01"}
+	delegation := types.FullDelegation{
+		Delegator:          "alice",
+		Validator:          "validator1",
+		Amount:             types.NewCoinFromUint64(100, "ustake"),
+		AccumulatedRewards: []types.Coin{types.NewCoinFromUint64(5, "ustake")},
+		CanRedelegate:      types.NewCoinFromUint64(80, "ustake"),
+	}
+
+	denom, err := querier.QueryBondedDenom()
+	require.NoError(t, err)
+	require.Equal(t, "ustake", denom)
+
+	validators, err := querier.QueryAllValidators()
+	require.NoError(t, err)
+	require.Equal(t, []types.Validator{validator}, validators)
+
+	found, err := querier.QueryValidator("validator1")
+	require.NoError(t, err)
+	require.Equal(t, &validator, found)
+	found, err = querier.QueryValidator("validator2")
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	delegations, err := querier.QueryAllDelegations("alice")
+	require.NoError(t, err)
+	require.Equal(t, []types.Delegation{{Delegator: "alice", Validator: "validator1", Amount: delegation.Amount}}, delegations)
+	delegations, err = querier.QueryAllDelegations("bob")
+	require.NoError(t, err)
+	require.Empty(t, delegations)
+
+	full, err := querier.QueryDelegation("alice", "validator1")
+	require.NoError(t, err)
+	require.Equal(t, &delegation, full)
+	full, err = querier.QueryDelegation("alice", "validator2")
+	require.NoError(t, err)
+	require.Nil(t, full)
+}